@@ -0,0 +1,15 @@
+# Acknowledge alert messages
+action "scc_alert_messages_acknowledge" "ack" {
+  config {
+    ids       = ["12345", "12346"]
+    operation = "ACKNOWLEDGE"
+  }
+}
+
+# Delete alert messages
+action "scc_alert_messages_acknowledge" "delete" {
+  config {
+    ids       = ["12347"]
+    operation = "DELETE"
+  }
+}
@@ -0,0 +1,20 @@
+# Toggle the state of a K8S service channel
+action "scc_service_channel_toggle" "toggle" {
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "123e4567-e89b-12d3-a456-426614174000"
+    type        = "K8S"
+    id          = 1
+  }
+}
+
+# Disable an ABAP Cloud service channel
+action "scc_service_channel_toggle" "disable" {
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "123e4567-e89b-12d3-a456-426614174000"
+    type        = "ABAPCloud"
+    id          = 2
+    enabled     = false
+  }
+}
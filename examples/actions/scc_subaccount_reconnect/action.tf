@@ -0,0 +1,16 @@
+# Reconnect the tunnel of a subaccount
+action "scc_subaccount_reconnect" "reconnect" {
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "123e4567-e89b-12d3-a456-426614174000"
+  }
+}
+
+# Disconnect the tunnel of a subaccount
+action "scc_subaccount_reconnect" "disconnect" {
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "123e4567-e89b-12d3-a456-426614174000"
+    operation   = "DISCONNECT"
+  }
+}
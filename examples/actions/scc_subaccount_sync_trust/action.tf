@@ -0,0 +1,7 @@
+# Synchronize the trust configuration of a subaccount
+action "scc_subaccount_sync_trust" "sync" {
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "123e4567-e89b-12d3-a456-426614174000"
+  }
+}
@@ -0,0 +1,14 @@
+# Read all alert messages
+data "scc_alert_messages" "all" {}
+
+# Fail the run if the Cloud Connector reports open critical alerts
+data "scc_alert_messages" "errors" {
+  severity = "ERROR"
+}
+
+check "no_open_critical_alerts" {
+  assert {
+    condition     = length([for m in data.scc_alert_messages.errors.alert_messages : m if !m.acknowledged]) == 0
+    error_message = "The Cloud Connector reports open critical alerts."
+  }
+}
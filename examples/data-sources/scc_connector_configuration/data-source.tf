@@ -0,0 +1,5 @@
+data "scc_connector_configuration" "configuration" {}
+
+output "connection_timeout" {
+  value = data.scc_connector_configuration.configuration.connection_timeout
+}
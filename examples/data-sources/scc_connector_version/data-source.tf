@@ -0,0 +1,5 @@
+data "scc_connector_version" "version" {}
+
+output "cloud_connector_version" {
+  value = data.scc_connector_version.version.version
+}
@@ -0,0 +1,8 @@
+data "scc_monitoring_hardware" "hardware" {}
+
+check "disk_space" {
+  assert {
+    condition     = data.scc_monitoring_hardware.hardware.disk.free > 1024
+    error_message = "Less than 1 GB of disk space left on the Cloud Connector host."
+  }
+}
@@ -0,0 +1,4 @@
+data "scc_monitoring_performance" "performance" {
+  region_host = "cf.eu12.hana.ondemand.com"
+  subaccount = "12345678-90ab-cdef-1234-567890abcdef"
+}
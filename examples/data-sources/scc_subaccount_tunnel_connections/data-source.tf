@@ -0,0 +1,13 @@
+# Read all open connections of a subaccount
+data "scc_subaccount_tunnel_connections" "all" {
+  region_host = "cf.eu12.hana.ondemand.com"
+  subaccount = "12345678-90ab-cdef-1234-567890abcdef"
+}
+
+# Read the open connections of an application to a virtual host
+data "scc_subaccount_tunnel_connections" "filtered" {
+  region_host = "cf.eu12.hana.ondemand.com"
+  subaccount = "12345678-90ab-cdef-1234-567890abcdef"
+  application = "businesspartner-app"
+  virtual_host = "s4hana.virtual"
+}
@@ -0,0 +1,25 @@
+# Read the authentication data from a file
+ephemeral "scc_subaccount_authentication_data" "from_file" {
+  file = "${path.module}/authentication.data"
+}
+
+# Read the authentication data from an environment variable
+ephemeral "scc_subaccount_authentication_data" "from_env" {
+  environment_variable = "SCC_AUTHENTICATION_DATA"
+}
+
+# Fetch the authentication data from a secret manager
+ephemeral "scc_subaccount_authentication_data" "from_url" {
+  url = "https://secrets.example.com/v1/scc/authentication-data"
+  http_headers = {
+    Authorization = "Bearer ${var.secret_manager_token}"
+  }
+}
+
+# Add the subaccount without persisting the authentication data in the state
+resource "scc_subaccount_using_auth" "scc_sa_auth" {
+  authentication_data_wo = ephemeral.scc_subaccount_authentication_data.from_file.authentication_data
+  authentication_data_wo_version = 1
+  display_name = "Subaccount_Terraform"
+  description = "Description for Subaccount added via Terraform."
+}
@@ -0,0 +1,4 @@
+# Decode the ID of a system mapping resource
+output "resource_path" {
+  value = provider::scc::decode_resource_id("-api-my+2Dservice") # "/api/my-service"
+}
@@ -0,0 +1,4 @@
+# Encode the URL path of a system mapping resource
+output "encoded_resource_id" {
+  value = provider::scc::encode_resource_id("/api/my-service") # "-api-my+2Dservice"
+}
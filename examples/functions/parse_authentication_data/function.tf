@@ -0,0 +1,13 @@
+# Read the subaccount from the authentication data
+locals {
+  authentication_data = provider::scc::parse_authentication_data(file("authentication_data.txt"))
+}
+
+output "subaccount" {
+  value = local.authentication_data.subaccount
+}
+
+# Check whether the authentication data is still valid
+output "authentication_data_valid" {
+  value = timecmp(local.authentication_data.expires_at, plantimestamp()) > 0
+}
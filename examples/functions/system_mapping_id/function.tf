@@ -0,0 +1,4 @@
+# Build the identifier of a system mapping
+output "system_mapping_id" {
+  value = provider::scc::system_mapping_id("virtual.example.com", "443") # "virtual.example.com:443"
+}
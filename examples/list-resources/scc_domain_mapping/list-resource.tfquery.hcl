@@ -0,0 +1,14 @@
+# List the domain mappings of all subaccounts
+list "scc_domain_mapping" "all" {
+  provider = scc
+}
+
+# List the domain mappings of a subaccount
+list "scc_domain_mapping" "subaccount" {
+  provider = scc
+
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "123e4567-e89b-12d3-a456-426614174000"
+  }
+}
@@ -0,0 +1,14 @@
+# List all subaccounts of the Cloud Connector
+list "scc_subaccount" "all" {
+  provider = scc
+}
+
+# List the subaccounts of a region including their attributes
+list "scc_subaccount" "region" {
+  provider         = scc
+  include_resource = true
+
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+  }
+}
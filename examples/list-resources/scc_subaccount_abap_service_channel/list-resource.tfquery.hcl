@@ -0,0 +1,14 @@
+# List the ABAP service channels of all subaccounts
+list "scc_subaccount_abap_service_channel" "all" {
+  provider = scc
+}
+
+# List the ABAP service channels of a subaccount
+list "scc_subaccount_abap_service_channel" "subaccount" {
+  provider = scc
+
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "123e4567-e89b-12d3-a456-426614174000"
+  }
+}
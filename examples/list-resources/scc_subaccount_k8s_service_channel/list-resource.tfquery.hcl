@@ -0,0 +1,14 @@
+# List the K8S service channels of all subaccounts
+list "scc_subaccount_k8s_service_channel" "all" {
+  provider = scc
+}
+
+# List the K8S service channels of a subaccount
+list "scc_subaccount_k8s_service_channel" "subaccount" {
+  provider = scc
+
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "123e4567-e89b-12d3-a456-426614174000"
+  }
+}
@@ -0,0 +1,14 @@
+# List the system mappings of all subaccounts
+list "scc_system_mapping" "all" {
+  provider = scc
+}
+
+# List the system mappings of a subaccount
+list "scc_system_mapping" "subaccount" {
+  provider = scc
+
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "123e4567-e89b-12d3-a456-426614174000"
+  }
+}
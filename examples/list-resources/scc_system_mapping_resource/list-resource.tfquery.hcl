@@ -0,0 +1,16 @@
+# List the system mapping resources of all subaccounts
+list "scc_system_mapping_resource" "all" {
+  provider = scc
+}
+
+# List the resources of a system mapping
+list "scc_system_mapping_resource" "system_mapping" {
+  provider = scc
+
+  config {
+    region_host  = "cf.eu12.hana.ondemand.com"
+    subaccount   = "123e4567-e89b-12d3-a456-426614174000"
+    virtual_host = "testtfvirtual"
+    virtual_port = "900"
+  }
+}
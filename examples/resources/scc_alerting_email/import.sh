@@ -0,0 +1,5 @@
+# terraform import scc_alerting_email.<resource_name> 'alerting_email'
+# The import identifier is not evaluated, as there is only one e-mail configuration per Cloud Connector instance.
+# For the same reason, this resource has no resource identity.
+
+terraform import scc_alerting_email.scc_ae 'alerting_email'
\ No newline at end of file
@@ -0,0 +1,16 @@
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "scc_alerting_email" "scc_ae" {
+    smtp_host = "smtp.example.com"
+    smtp_port = 587
+    smtp_user = "scc-alerts"
+    smtp_password_wo = var.smtp_password
+    smtp_password_wo_version = 1
+    sender = "scc@example.com"
+    recipients = ["basis-team@example.com"]
+    tls_enabled = true
+    send_test_email = true
+}
@@ -0,0 +1,5 @@
+# terraform import scc_alerting_settings.<resource_name> 'alerting_settings'
+# The import identifier is not evaluated, as there is only one alerting configuration per Cloud Connector instance.
+# For the same reason, this resource has no resource identity.
+
+terraform import scc_alerting_settings.scc_as 'alerting_settings'
\ No newline at end of file
@@ -0,0 +1,3 @@
+resource "scc_alerting_settings" "scc_as" {
+    alert_types = ["TUNNEL_DOWN", "SERVICE_CHANNEL_DOWN", "CERTIFICATE_EXPIRATION"]
+}
@@ -0,0 +1,5 @@
+# terraform import scc_connector_configuration.<resource_name> 'connector_configuration'
+# The import identifier is not evaluated, as there is only one connector configuration per Cloud Connector instance.
+# For the same reason, this resource has no resource identity.
+
+terraform import scc_connector_configuration.scc_configuration 'connector_configuration'
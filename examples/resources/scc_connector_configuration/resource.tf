@@ -0,0 +1,9 @@
+resource "scc_connector_configuration" "scc_configuration" {
+    connection_timeout = 60
+    min_thread_pool_size = 20
+    max_thread_pool_size = 200
+    max_payload_size = 500
+    allow_http_websocket_cloud = false
+    allow_http_websocket_on_premise = false
+    disable_ui = false
+}
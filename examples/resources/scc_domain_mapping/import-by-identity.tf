@@ -0,0 +1,8 @@
+import {
+  to = scc_domain_mapping.scc_dm
+  identity = {
+    region_host     = "cf.eu12.hana.ondemand.com"
+    subaccount      = "12345678-90ab-cdef-1234-567890abcdef"
+    internal_domain = "my.internal.domain.com"
+  }
+}
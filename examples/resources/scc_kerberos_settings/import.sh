@@ -0,0 +1,5 @@
+# terraform import scc_kerberos_settings.<resource_name> 'kerberos_settings'
+# The import identifier is not evaluated, as there is only one Kerberos configuration per Cloud Connector instance.
+# For the same reason, this resource has no resource identity.
+
+terraform import scc_kerberos_settings.scc_kerberos 'kerberos_settings'
\ No newline at end of file
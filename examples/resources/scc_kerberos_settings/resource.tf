@@ -0,0 +1,11 @@
+resource "scc_kerberos_settings" "scc_kerberos" {
+    realms = [
+      {
+        name = "EXAMPLE.COM"
+        kdc_hosts = ["kdc1.example.com:88", "kdc2.example.com:88"]
+      },
+    ]
+    service_user = "scc-service@EXAMPLE.COM"
+    keytab_wo = filebase64("${path.module}/scc-service.keytab")
+    keytab_wo_version = 1
+}
@@ -0,0 +1,5 @@
+# terraform import scc_ldap_authentication.<resource_name> 'ldap_authentication'
+# The import identifier is not evaluated, as there is only one LDAP configuration per Cloud Connector instance.
+# For the same reason, this resource has no resource identity.
+
+terraform import scc_ldap_authentication.scc_ldap 'ldap_authentication'
\ No newline at end of file
@@ -0,0 +1,26 @@
+variable "ldap_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "scc_ldap_authentication" "scc_ldap" {
+    hosts = [
+      { host = "ldap1.example.com", port = 636, tls_enabled = true },
+      { host = "ldap2.example.com", port = 636, tls_enabled = true },
+    ]
+    secondary_hosts = [
+      { host = "ldap-dr.example.com", port = 636, tls_enabled = true },
+    ]
+    user_base_dn = "ou=users,dc=example,dc=com"
+    group_base_dn = "ou=groups,dc=example,dc=com"
+    connection_user = "cn=scc-bind,ou=services,dc=example,dc=com"
+    connection_password_wo = var.ldap_password
+    connection_password_wo_version = 1
+    role_mapping = {
+      administrator = "scc-admins"
+      display = "scc-viewers"
+      support = "scc-support"
+      subaccount_administrator = "scc-subaccount-admins"
+    }
+    test_connection = true
+}
@@ -0,0 +1,5 @@
+# terraform import scc_local_user.<resource_name> 'local_user'
+# The import identifier is not evaluated, as there is only one local administrator user per Cloud Connector instance.
+# For the same reason, this resource has no resource identity.
+
+terraform import scc_local_user.scc_admin 'local_user'
\ No newline at end of file
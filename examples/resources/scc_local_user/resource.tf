@@ -0,0 +1,9 @@
+variable "new_admin_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "scc_local_user" "scc_admin" {
+    password_wo = var.new_admin_password
+    password_wo_version = 1
+}
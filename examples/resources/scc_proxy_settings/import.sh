@@ -0,0 +1,5 @@
+# terraform import scc_proxy_settings.<resource_name> 'proxy_settings'
+# The import identifier is not evaluated, as there is only one proxy configuration per Cloud Connector instance.
+# For the same reason, this resource has no resource identity.
+
+terraform import scc_proxy_settings.scc_proxy 'proxy_settings'
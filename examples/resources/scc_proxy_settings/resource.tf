@@ -0,0 +1,13 @@
+variable "proxy_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "scc_proxy_settings" "scc_proxy" {
+    host = "proxy.example.com"
+    port = 8080
+    user = "proxyuser"
+    password_wo = var.proxy_password
+    password_wo_version = 1
+    non_proxy_hosts = ["localhost", "*.corp.example.com"]
+}
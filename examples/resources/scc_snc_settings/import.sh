@@ -0,0 +1,5 @@
+# terraform import scc_snc_settings.<resource_name> 'snc_settings'
+# The import identifier is not evaluated, as there is only one SNC configuration per Cloud Connector instance.
+# For the same reason, this resource has no resource identity.
+
+terraform import scc_snc_settings.scc_snc 'snc_settings'
\ No newline at end of file
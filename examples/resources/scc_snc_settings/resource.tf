@@ -0,0 +1,30 @@
+resource "scc_snc_settings" "scc_snc" {
+    library_path = "/usr/sap/scc/libsapcrypto.so"
+    my_name = "p:CN=SCC, O=Example, C=DE"
+    quality_of_protection = "Privacy"
+}
+
+# Secure RFC access to an on-premise ABAP system using the SNC settings above
+resource "scc_system_mapping" "scc_rfcs" {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount = "12345678-90ab-cdef-1234-567890abcdef"
+    virtual_host = "virtual-abap.example.com"
+    virtual_port = "sapgw00"
+    internal_host = "abap.example.com"
+    internal_port = "sapgw00"
+    protocol = "RFCS"
+    backend_type = "abapSys"
+    authentication_mode = "NONE"
+
+    depends_on = [scc_snc_settings.scc_snc]
+}
+
+resource "scc_system_mapping_resource" "scc_rfcs_function" {
+    region_host = scc_system_mapping.scc_rfcs.region_host
+    subaccount = scc_system_mapping.scc_rfcs.subaccount
+    virtual_host = scc_system_mapping.scc_rfcs.virtual_host
+    virtual_port = scc_system_mapping.scc_rfcs.virtual_port
+    url_path = "BAPI_"
+    path_only = false
+    enabled = true
+}
@@ -0,0 +1,5 @@
+# terraform import scc_solution_management.<resource_name> 'solution_management'
+# The import identifier is not evaluated, as there is only one solution management configuration per Cloud Connector instance.
+# For the same reason, this resource has no resource identity.
+
+terraform import scc_solution_management.scc_sm 'solution_management'
\ No newline at end of file
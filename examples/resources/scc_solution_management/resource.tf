@@ -0,0 +1,7 @@
+resource "scc_solution_management" "scc_sm" {
+    enabled = true
+    host_agent_path = "/usr/sap/hostctrl/exe"
+    target_system_type = "SLD"
+    target_url = "https://sld.example.com:50000"
+    registration_file_path = "${path.module}/scc_registration.txt"
+}
@@ -0,0 +1,7 @@
+import {
+  to = scc_subaccount.scc_sa
+  identity = {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "12345678-90ab-cdef-1234-567890abcdef"
+  }
+}
@@ -5,4 +5,15 @@ resource "scc_subaccount" "scc_sa" {
   cloud_password = "Cloud Password"
   display_name = "Subaccount_Terraform"
   description = "Description for Subaccount added via Terraform."
-}
\ No newline at end of file
+}
+
+# Keep the cloud password out of the Terraform state
+resource "scc_subaccount" "scc_sa_wo" {
+  region_host = "cf.eu12.hana.ondemand.com"
+  subaccount = "12345678-90ab-cdef-1234-567890abcdef"
+  cloud_user = "Cloud Username"
+  cloud_password_wo = "Cloud Password"
+  cloud_password_wo_version = 1
+  display_name = "Subaccount_Terraform"
+  description = "Description for Subaccount added via Terraform."
+}
@@ -0,0 +1,8 @@
+import {
+  to = scc_subaccount_abap_service_channel.scc_sc
+  identity = {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "12345678-90ab-cdef-1234-567890abcdef"
+    id          = 1
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = scc_subaccount_using_auth.scc_sa_auth
+  identity = {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "12345678-90ab-cdef-1234-567890abcdef"
+  }
+}
@@ -2,4 +2,12 @@ resource "scc_subaccount_using_auth" "scc_sa_auth" {
   authentication_data = file("${path.module}/authentication.data")
   display_name = "Subaccount_Terraform"
   description = "Description for Subaccount added via Terraform."
-}
\ No newline at end of file
+}
+
+# Keep the authentication data out of the Terraform state
+resource "scc_subaccount_using_auth" "scc_sa_auth_wo" {
+  authentication_data_wo = file("${path.module}/authentication.data")
+  authentication_data_wo_version = 1
+  display_name = "Subaccount_Terraform"
+  description = "Description for Subaccount added via Terraform."
+}
@@ -0,0 +1,29 @@
+package apiobjects
+
+type AlertingEmail struct {
+	SMTPHost   string   `json:"smtpHost"`
+	SMTPPort   int64    `json:"smtpPort"`
+	SMTPUser   string   `json:"smtpUser"`
+	Sender     string   `json:"sender"`
+	Recipients []string `json:"recipients"`
+	TLSEnabled bool     `json:"tlsEnabled"`
+}
+
+type AlertingSettings struct {
+	AlertTypes []string `json:"alertTypes"`
+}
+
+type AlertMessage struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	Severity     string `json:"severity"`
+	Timestamp    int64  `json:"timestamp"`
+	RegionHost   string `json:"regionHost"`
+	Subaccount   string `json:"subaccount"`
+	Message      string `json:"message"`
+	Acknowledged bool   `json:"acknowledged"`
+}
+
+type AlertMessages struct {
+	AlertMessages []AlertMessage `json:"alert_messages"`
+}
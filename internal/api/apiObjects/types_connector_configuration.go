@@ -0,0 +1,11 @@
+package apiobjects
+
+type ConnectorConfiguration struct {
+	ConnectionTimeout           int64 `json:"connectionTimeout"`
+	MinThreadPoolSize           int64 `json:"minThreadPoolSize"`
+	MaxThreadPoolSize           int64 `json:"maxThreadPoolSize"`
+	MaxPayloadSize              int64 `json:"maxPayloadSize"`
+	AllowHTTPWebSocketCloud     bool  `json:"allowHTTPWebSocketCloud"`
+	AllowHTTPWebSocketOnPremise bool  `json:"allowHTTPWebSocketOnPremise"`
+	UIDisabled                  bool  `json:"uiDisabled"`
+}
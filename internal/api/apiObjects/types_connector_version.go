@@ -0,0 +1,5 @@
+package apiobjects
+
+type ConnectorVersion struct {
+	Version string `json:"version"`
+}
@@ -0,0 +1,11 @@
+package apiobjects
+
+type KerberosRealm struct {
+	Name string   `json:"name"`
+	KDCs []string `json:"kdcs"`
+}
+
+type KerberosSettings struct {
+	Realms      []KerberosRealm `json:"realms"`
+	ServiceUser string          `json:"serviceUser"`
+}
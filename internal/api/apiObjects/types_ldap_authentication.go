@@ -0,0 +1,24 @@
+package apiobjects
+
+type LDAPHost struct {
+	Host       string `json:"host"`
+	Port       int64  `json:"port"`
+	TLSEnabled bool   `json:"secure"`
+}
+
+type LDAPRoleMapping struct {
+	Administrator           string `json:"admin"`
+	Display                 string `json:"display"`
+	Support                 string `json:"support"`
+	SubaccountAdministrator string `json:"subaccountAdmin"`
+}
+
+type LDAPAuthentication struct {
+	Enabled        bool            `json:"enabled"`
+	Hosts          []LDAPHost      `json:"hosts"`
+	SecondaryHosts []LDAPHost      `json:"secondaryHosts"`
+	UserBaseDN     string          `json:"userBase"`
+	GroupBaseDN    string          `json:"groupBase"`
+	ConnectionUser string          `json:"user"`
+	Roles          LDAPRoleMapping `json:"roles"`
+}
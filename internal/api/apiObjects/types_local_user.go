@@ -0,0 +1,5 @@
+package apiobjects
+
+type LocalUser struct {
+	User string `json:"user"`
+}
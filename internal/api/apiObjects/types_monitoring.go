@@ -0,0 +1,64 @@
+package apiobjects
+
+type MonitoringHardware struct {
+	CPU      MonitoringCPU      `json:"cpu"`
+	Memory   MonitoringCapacity `json:"memory"`
+	Disk     MonitoringCapacity `json:"disk"`
+	JavaHeap MonitoringCapacity `json:"javaHeap"`
+}
+
+type MonitoringCPU struct {
+	Usage float64 `json:"usage"`
+	Cores int64   `json:"cores"`
+}
+
+type MonitoringCapacity struct {
+	Total int64 `json:"total"`
+	Used  int64 `json:"used"`
+	Free  int64 `json:"free"`
+}
+
+type MonitoringPerformance struct {
+	SinceTimeStamp int64                          `json:"sinceTimeStamp"`
+	Backends       []MonitoringPerformanceBackend `json:"backends"`
+}
+
+type MonitoringPerformanceBackend struct {
+	VirtualHost     string `json:"virtualHost"`
+	VirtualPort     string `json:"virtualPort"`
+	Protocol        string `json:"protocol"`
+	Requests        int64  `json:"requests"`
+	AverageDuration int64  `json:"averageDuration"`
+	MaximumDuration int64  `json:"maximumDuration"`
+}
+
+type MonitoringTopTimeConsumer struct {
+	TimeStamp       int64  `json:"timeStamp"`
+	VirtualHost     string `json:"virtualHost"`
+	VirtualPort     string `json:"virtualPort"`
+	Protocol        string `json:"protocol"`
+	Resource        string `json:"resource"`
+	User            string `json:"user"`
+	TotalDuration   int64  `json:"totalDuration"`
+	BackendDuration int64  `json:"backendDuration"`
+	SentBytes       int64  `json:"sentBytes"`
+	ReceivedBytes   int64  `json:"receivedBytes"`
+}
+
+type MonitoringTopTimeConsumers struct {
+	TopTimeConsumers []MonitoringTopTimeConsumer `json:"top_time_consumers"`
+}
+
+type SubaccountTunnelConnection struct {
+	Application          string `json:"application"`
+	VirtualHost          string `json:"virtualHost"`
+	VirtualPort          string `json:"virtualPort"`
+	Protocol             string `json:"protocol"`
+	OpenedSinceTimeStamp int64  `json:"openedSinceTimeStamp"`
+	SentBytes            int64  `json:"sentBytes"`
+	ReceivedBytes        int64  `json:"receivedBytes"`
+}
+
+type SubaccountTunnelConnections struct {
+	Connections []SubaccountTunnelConnection `json:"connections"`
+}
@@ -0,0 +1,8 @@
+package apiobjects
+
+type ProxySettings struct {
+	Host          string   `json:"proxyHost"`
+	Port          int64    `json:"proxyPort"`
+	User          string   `json:"proxyUser"`
+	NonProxyHosts []string `json:"nonProxyHosts"`
+}
@@ -0,0 +1,7 @@
+package apiobjects
+
+type SNCSettings struct {
+	LibraryPath         string `json:"libraryPath"`
+	MyName              string `json:"myName"`
+	QualityOfProtection string `json:"qop"`
+}
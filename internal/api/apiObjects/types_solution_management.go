@@ -0,0 +1,8 @@
+package apiobjects
+
+type SolutionManagement struct {
+	Enabled          bool   `json:"enabled"`
+	HostAgentPath    string `json:"hostAgentPath"`
+	TargetSystemType string `json:"targetSystemType"`
+	TargetURL        string `json:"targetUrl"`
+}
@@ -10,13 +10,18 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
 )
 
 type RestApiClient struct {
 	Client   *http.Client
 	BaseURL  *url.URL
 	Username string
-	Password string
+	// ConnectorVersion is the version of the connected Cloud Connector instance, nil if it could not be determined
+	ConnectorVersion *ConnectorVersion
+	// password is only accessed through SetPassword and CurrentPassword, as it can be rotated while other requests are in flight
+	password         string
+	credentialsMutex sync.RWMutex
 }
 
 type ErrorResponse struct {
@@ -46,7 +51,7 @@ func NewRestApiClient(client *http.Client, baseURL *url.URL, username, password
 		BaseURL:  baseURL,
 		Client:   client,
 		Username: username,
-		Password: password,
+		password: password,
 	}, nil
 }
 
@@ -133,8 +138,8 @@ func (c *RestApiClient) DoRequest(method string, endpoint string, body []byte) (
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "*/*")
 
-	if c.Username != "" && c.Password != "" {
-		req.SetBasicAuth(c.Username, c.Password)
+	if password := c.CurrentPassword(); c.Username != "" && password != "" {
+		req.SetBasicAuth(c.Username, password)
 	}
 
 	resp, err := c.Client.Do(req)
@@ -149,6 +154,32 @@ func (c *RestApiClient) DoRequest(method string, endpoint string, body []byte) (
 
 }
 
+// SetPassword replaces the password used for basic authentication in all subsequent requests
+func (c *RestApiClient) SetPassword(password string) {
+	c.credentialsMutex.Lock()
+	defer c.credentialsMutex.Unlock()
+
+	c.password = password
+}
+
+// CurrentPassword returns the password currently used for basic authentication
+func (c *RestApiClient) CurrentPassword() string {
+	c.credentialsMutex.RLock()
+	defer c.credentialsMutex.RUnlock()
+
+	return c.password
+}
+
+// ResponseError is returned for requests that the Cloud Connector answers with an error status.
+type ResponseError struct {
+	StatusCode int
+	message    string
+}
+
+func (e *ResponseError) Error() string {
+	return e.message
+}
+
 func validateResponse(response *http.Response) error {
 	if response.StatusCode == http.StatusOK ||
 		response.StatusCode == http.StatusCreated ||
@@ -160,20 +191,20 @@ func validateResponse(response *http.Response) error {
 
 	// Handle 401 Unauthorized explicitly
 	if response.StatusCode == http.StatusUnauthorized {
-		return fmt.Errorf("authentication rejected: HTTP %d for %s %s. Response: %s",
-			response.StatusCode, response.Request.Method, response.Request.URL, string(bodyBytes))
+		return &ResponseError{StatusCode: response.StatusCode, message: fmt.Sprintf("authentication rejected: HTTP %d for %s %s. Response: %s",
+			response.StatusCode, response.Request.Method, response.Request.URL, string(bodyBytes))}
 	}
 
 	// Attempt to decode a structured error message
 	var errorResp ErrorResponse
 	if err := json.Unmarshal(bodyBytes, &errorResp); err == nil && errorResp.Message != "" {
-		return fmt.Errorf("HTTP %s %s failed with status %d: %s",
-			response.Request.Method, response.Request.URL, response.StatusCode, errorResp.Message)
+		return &ResponseError{StatusCode: response.StatusCode, message: fmt.Sprintf("HTTP %s %s failed with status %d: %s",
+			response.Request.Method, response.Request.URL, response.StatusCode, errorResp.Message)}
 	}
 
 	// Fallback to raw body
-	return fmt.Errorf("HTTP %s %s failed with status %d. Raw response: %s",
-		response.Request.Method, response.Request.URL, response.StatusCode, string(bodyBytes))
+	return &ResponseError{StatusCode: response.StatusCode, message: fmt.Sprintf("HTTP %s %s failed with status %d. Raw response: %s",
+		response.Request.Method, response.Request.URL, response.StatusCode, string(bodyBytes))}
 }
 
 func (c *RestApiClient) GetRequest(endpoint string) (*http.Response, error) {
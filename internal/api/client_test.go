@@ -8,6 +8,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
@@ -50,6 +51,44 @@ func TestRestApiClient_BasicAuth(t *testing.T) {
 	})
 }
 
+func TestRestApiClient_SetPassword(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/success", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "testuser" || password != "rotatedpassword" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client, err := createBasicAuthClient(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create basic auth client: %v", err)
+	}
+
+	if _, err = client.GetRequest("/success"); err == nil {
+		t.Fatal("expected the request with the initial password to be rejected")
+	}
+
+	client.SetPassword("rotatedpassword")
+
+	if client.CurrentPassword() != "rotatedpassword" {
+		t.Errorf("expected rotated password, got %q", client.CurrentPassword())
+	}
+
+	resp, err := client.GetRequest("/success")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected 200 OK, got %d", resp.StatusCode)
+	}
+}
+
 func TestRestApiClient_CertificateAuth(t *testing.T) {
 	// Generate server cert
 	serverCertPEM, serverKeyPEM, _, err := generateSelfSignedCert()
@@ -239,6 +278,22 @@ func TestValidateResponse_ErrorWithInvalidJSON(t *testing.T) {
 	}
 }
 
+func TestValidateResponse_ErrorKeepsStatusCode(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com/missing", nil)
+
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Request:    req,
+		Body:       io.NopCloser(bytes.NewBufferString(`{"type":"NOT_FOUND","message":"not found"}`)),
+	}
+
+	err := validateResponse(resp)
+	var responseErr *ResponseError
+	if !errors.As(err, &responseErr) || responseErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a response error with status 404, got: %v", err)
+	}
+}
+
 func createBasicAuthClient(serverURL string) (*RestApiClient, error) {
 	baseURL, err := url.Parse(serverURL)
 	if err != nil {
@@ -0,0 +1,19 @@
+package endpoints
+
+import "fmt"
+
+func GetAlertingEmailEndpoint() string {
+	return "/api/v1/configuration/connector/alerting/email"
+}
+
+func GetAlertingSettingsEndpoint() string {
+	return "/api/v1/configuration/connector/alerting/settings"
+}
+
+func GetAlertMessageEndpoint(id string) string {
+	return fmt.Sprintf(GetAlertMessagesBaseEndpoint()+"/%s", id)
+}
+
+func GetAlertMessagesBaseEndpoint() string {
+	return "/api/v1/alerting/messages"
+}
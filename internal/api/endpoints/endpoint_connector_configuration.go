@@ -0,0 +1,5 @@
+package endpoints
+
+func GetConnectorConfigurationEndpoint() string {
+	return "/api/v1/configuration/connector/advanced"
+}
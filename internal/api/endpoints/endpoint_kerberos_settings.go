@@ -0,0 +1,5 @@
+package endpoints
+
+func GetKerberosSettingsEndpoint() string {
+	return "/api/v1/configuration/connector/onPremise/kerberos"
+}
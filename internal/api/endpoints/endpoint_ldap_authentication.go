@@ -0,0 +1,9 @@
+package endpoints
+
+func GetLDAPAuthenticationEndpoint() string {
+	return "/api/v1/configuration/connector/authentication/ldap"
+}
+
+func GetLDAPAuthenticationTestEndpoint() string {
+	return GetLDAPAuthenticationEndpoint() + "/test"
+}
@@ -0,0 +1,5 @@
+package endpoints
+
+func GetLocalUserEndpoint() string {
+	return "/api/v1/configuration/connector/authentication/basic"
+}
@@ -0,0 +1,23 @@
+package endpoints
+
+import "fmt"
+
+func GetMonitoringHardwareEndpoint() string {
+	return "/api/v1/metrics/hardware"
+}
+
+func GetMonitoringPerformanceEndpoint(regionHost, subaccount string) string {
+	return GetMonitoringSubaccountBaseEndpoint(regionHost, subaccount) + "/performance"
+}
+
+func GetMonitoringTopTimeConsumersEndpoint(regionHost, subaccount string) string {
+	return GetMonitoringSubaccountBaseEndpoint(regionHost, subaccount) + "/topTimeConsumers"
+}
+
+func GetMonitoringSubaccountBaseEndpoint(regionHost, subaccount string) string {
+	return fmt.Sprintf("/api/v1/metrics/subaccounts/%s/%s", regionHost, subaccount)
+}
+
+func GetMonitoringTunnelConnectionsEndpoint(regionHost, subaccount string) string {
+	return GetMonitoringSubaccountBaseEndpoint(regionHost, subaccount) + "/connections"
+}
@@ -0,0 +1,5 @@
+package endpoints
+
+func GetProxySettingsEndpoint() string {
+	return "/api/v1/configuration/connector/proxy"
+}
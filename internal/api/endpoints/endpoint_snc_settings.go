@@ -0,0 +1,5 @@
+package endpoints
+
+func GetSNCSettingsEndpoint() string {
+	return "/api/v1/configuration/connector/onPremise/snc"
+}
@@ -0,0 +1,9 @@
+package endpoints
+
+func GetSolutionManagementEndpoint() string {
+	return "/api/v1/configuration/connector/solutionManagement"
+}
+
+func GetSolutionManagementRegistrationFileEndpoint() string {
+	return GetSolutionManagementEndpoint() + "/registrationFile"
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ConnectorVersion is the release version of a Cloud Connector instance, e.g. 2.18.1
+type ConnectorVersion struct {
+	Major int64
+	Minor int64
+	Patch int64
+}
+
+// ParseConnectorVersion parses version strings as returned by the Cloud Connector.
+// A missing patch version is treated as 0, suffixes after the patch version (e.g. "-SNAPSHOT") are ignored.
+func ParseConnectorVersion(version string) (ConnectorVersion, error) {
+	parts := strings.SplitN(strings.TrimSpace(version), ".", 3)
+	if len(parts) < 2 {
+		return ConnectorVersion{}, fmt.Errorf("invalid Cloud Connector version %q", version)
+	}
+
+	if len(parts) == 3 {
+		parts[2], _, _ = strings.Cut(parts[2], "-")
+	} else {
+		parts = append(parts, "0")
+	}
+
+	var numbers [3]int64
+	for i, part := range parts {
+		number, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || number < 0 {
+			return ConnectorVersion{}, fmt.Errorf("invalid Cloud Connector version %q", version)
+		}
+		numbers[i] = number
+	}
+
+	return ConnectorVersion{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// MustParseConnectorVersion is like ParseConnectorVersion but panics if the version cannot be parsed.
+// It is meant for version constants in the provider code.
+func MustParseConnectorVersion(version string) ConnectorVersion {
+	v, err := ParseConnectorVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (v ConnectorVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is lower, equal or higher than other.
+func (v ConnectorVersion) Compare(other ConnectorVersion) int {
+	for _, diff := range []int64{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		if diff < 0 {
+			return -1
+		}
+		if diff > 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// AtLeast reports whether v is equal to or higher than minimum.
+func (v ConnectorVersion) AtLeast(minimum ConnectorVersion) bool {
+	return v.Compare(minimum) >= 0
+}
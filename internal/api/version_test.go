@@ -0,0 +1,67 @@
+package api
+
+import "testing"
+
+func TestParseConnectorVersion(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ConnectorVersion
+		wantErr bool
+	}{
+		{input: "2.18.1", want: ConnectorVersion{Major: 2, Minor: 18, Patch: 1}},
+		{input: "2.17", want: ConnectorVersion{Major: 2, Minor: 17, Patch: 0}},
+		{input: " 2.16.2 ", want: ConnectorVersion{Major: 2, Minor: 16, Patch: 2}},
+		{input: "2.19.0-SNAPSHOT", want: ConnectorVersion{Major: 2, Minor: 19, Patch: 0}},
+		{input: "", wantErr: true},
+		{input: "2", wantErr: true},
+		{input: "2.x.1", wantErr: true},
+		{input: "version info", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseConnectorVersion(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for %q, got %v", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestConnectorVersion_AtLeast(t *testing.T) {
+	tests := []struct {
+		version string
+		minimum string
+		want    bool
+	}{
+		{version: "2.18.1", minimum: "2.17.0", want: true},
+		{version: "2.17.0", minimum: "2.17.0", want: true},
+		{version: "2.16.2", minimum: "2.17.0", want: false},
+		{version: "3.0.0", minimum: "2.17.0", want: true},
+		{version: "2.17.0", minimum: "2.17.1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version+">="+tt.minimum, func(t *testing.T) {
+			got := MustParseConnectorVersion(tt.version).AtLeast(MustParseConnectorVersion(tt.minimum))
+			if got != tt.want {
+				t.Errorf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestConnectorVersion_String(t *testing.T) {
+	if got := MustParseConnectorVersion("2.17").String(); got != "2.17.0" {
+		t.Errorf("expected 2.17.0, got %s", got)
+	}
+}
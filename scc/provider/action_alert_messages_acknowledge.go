@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &AlertMessagesAcknowledgeAction{}
+
+func NewAlertMessagesAcknowledgeAction() action.Action {
+	return &AlertMessagesAcknowledgeAction{}
+}
+
+type AlertMessagesAcknowledgeAction struct {
+	client *api.RestApiClient
+}
+
+func (a *AlertMessagesAcknowledgeAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_messages_acknowledge"
+}
+
+func (a *AlertMessagesAcknowledgeAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Alert Messages Acknowledge Action.
+
+Acknowledges or deletes alert messages of the Cloud Connector, for example after the root cause of the alerts has been remediated. The IDs of the alert messages can be taken from the ` + "`scc_alert_messages`" + ` data source.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/alerting>`,
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the alert messages to be processed.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"operation": schema.StringAttribute{
+				MarkdownDescription: "Operation that is executed for the alert messages. Defaults to `ACKNOWLEDGE`. Possible values are:" +
+					getFormattedValueAsTableRow("operation", "description") +
+					getFormattedValueAsTableRow("---", "---") +
+					getFormattedValueAsTableRow("ACKNOWLEDGE", "Marks the alert messages as acknowledged") +
+					getFormattedValueAsTableRow("DELETE", "Removes the alert messages from the Cloud Connector"),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ACKNOWLEDGE", "DELETE"),
+				},
+			},
+		},
+	}
+}
+
+func (a *AlertMessagesAcknowledgeAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *AlertMessagesAcknowledgeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config AlertMessagesAcknowledgeConfig
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	diags = config.IDs.ElementsAs(ctx, &ids, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteMessages := config.Operation.ValueString() == "DELETE"
+
+	for _, id := range ids {
+		var respObj any
+		endpoint := endpoints.GetAlertMessageEndpoint(id)
+
+		if deleteMessages {
+			err := requestAndUnmarshal(a.client, &respObj, "DELETE", endpoint, nil, false)
+			if err != nil {
+				resp.Diagnostics.AddError(errMsgDeleteAlertMessageFailed, err.Error())
+				return
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Deleted alert message %s", id)})
+			continue
+		}
+
+		planBody := map[string]any{
+			"acknowledged": true,
+		}
+
+		err := requestAndUnmarshal(a.client, &respObj, "PUT", endpoint, planBody, false)
+		if err != nil {
+			resp.Diagnostics.AddError(errMsgAcknowledgeAlertMessageFailed, err.Error())
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Acknowledged alert message %s", id)})
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestActionAlertMessagesAcknowledge(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/action_alert_messages_acknowledge")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ActionAlertMessagesAcknowledge("ack", `["1"]`, "ACKNOWLEDGE") + actionTrigger("ack", "action.scc_alert_messages_acknowledge.ack"),
+				},
+				{
+					Config: providerConfig(user) + ActionAlertMessagesAcknowledge("ack", `["1"]`, "ACKNOWLEDGE") + actionTrigger("ack", "action.scc_alert_messages_acknowledge.ack") + DataSourceAlertMessages("messages"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.#", "3"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.id", "1"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.acknowledged", "true"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.1.acknowledged", "false"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ActionAlertMessagesAcknowledge("delete", `["2", "3"]`, "DELETE") + actionTrigger("delete", "action.scc_alert_messages_acknowledge.delete"),
+				},
+				{
+					Config: providerConfig(user) + ActionAlertMessagesAcknowledge("delete", `["2", "3"]`, "DELETE") + actionTrigger("delete", "action.scc_alert_messages_acknowledge.delete") + DataSourceAlertMessages("messages"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.#", "1"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.id", "1"),
+					),
+				},
+			},
+		})
+
+	})
+
+	t.Run("error path - ids mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ActionAlertMessagesAcknowledgeWoIDs("ack"),
+					ExpectError: regexp.MustCompile(`The argument "ids" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid operation", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ActionAlertMessagesAcknowledge("ack", `["1"]`, "ARCHIVE") + actionTrigger("ack", "action.scc_alert_messages_acknowledge.ack"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute operation value must be one of:.*"ACKNOWLEDGE"`),
+				},
+			},
+		})
+	})
+
+}
+
+func ActionAlertMessagesAcknowledge(actionName string, ids string, operation string) string {
+	return fmt.Sprintf(`
+	action "scc_alert_messages_acknowledge" "%s" {
+	config {
+	ids = %s
+	operation = "%s"
+	}
+	}
+	`, actionName, ids, operation)
+}
+
+func ActionAlertMessagesAcknowledgeWoIDs(actionName string) string {
+	return fmt.Sprintf(`
+	action "scc_alert_messages_acknowledge" "%s" {
+	config {
+	operation = "ACKNOWLEDGE"
+	}
+	}
+	`, actionName)
+}
+
+// actionTrigger invokes the given action once, after the creation of a terraform_data resource.
+func actionTrigger(resourceName string, actionAddress string) string {
+	return fmt.Sprintf(`
+	resource "terraform_data" "%s" {
+	lifecycle {
+	action_trigger {
+	events = [after_create]
+	actions = [%s]
+	}
+	}
+	}
+	`, resourceName, actionAddress)
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ action.ActionWithConfigure = &ServiceChannelToggleAction{}
+var _ action.ActionWithModifyPlan = &ServiceChannelToggleAction{}
+
+func NewServiceChannelToggleAction() action.Action {
+	return &ServiceChannelToggleAction{}
+}
+
+type ServiceChannelToggleAction struct {
+	client *api.RestApiClient
+}
+
+func (a *ServiceChannelToggleAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_channel_toggle"
+}
+
+func (a *ServiceChannelToggleAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Service Channel Toggle Action.
+
+Enables or disables a service channel of a subaccount, for example to temporarily close a channel during maintenance, without changing the ` + "`enabled`" + ` attribute of the service channel resources.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount-service-channels>`,
+		Attributes: map[string]schema.Attribute{
+			"region_host": schema.StringAttribute{
+				MarkdownDescription: "Region Host Name.",
+				Required:            true,
+			},
+			"subaccount": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the service channel. Possible values are:" +
+					getFormattedValueAsTableRow("type", "description") +
+					getFormattedValueAsTableRow("---", "---") +
+					getFormattedValueAsTableRow("K8S", "Service channel to a Kubernetes cluster") +
+					getFormattedValueAsTableRow("ABAPCloud", "Service channel to an ABAP Cloud tenant"),
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("K8S", "ABAPCloud"),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique identifier for the subaccount service channel.",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the service channel is enabled after the action. If not set, the current state of the service channel is inverted.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *ServiceChannelToggleAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *ServiceChannelToggleAction) ModifyPlan(ctx context.Context, req action.ModifyPlanRequest, resp *action.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkConnectorCapabilities(ctx, a.client, "action", "scc_service_channel_toggle", req.Config)...)
+}
+
+func (a *ServiceChannelToggleAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config ServiceChannelToggleConfig
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.ID.ValueInt64()
+	endpoint := endpoints.GetSubaccountServiceChannelEndpoint(config.RegionHost.ValueString(), config.Subaccount.ValueString(), config.Type.ValueString(), id)
+
+	enabled := config.Enabled.ValueBool()
+	if config.Enabled.IsNull() {
+		// Both service channel types share the enabled flag, so the type specific fields are not needed
+		var respObj struct {
+			Enabled bool `json:"enabled"`
+		}
+		if err := requestAndUnmarshal(a.client, &respObj, "GET", endpoint, nil, true); err != nil {
+			resp.Diagnostics.AddError(errMsgFetchServiceChannelFailed, err.Error())
+			return
+		}
+		enabled = !respObj.Enabled
+	}
+
+	var respObj any
+	planBody := map[string]string{
+		"enabled": fmt.Sprintf("%t", enabled),
+	}
+
+	if err := requestAndUnmarshal(a.client, &respObj, "PUT", endpoint+"/state", planBody, false); err != nil {
+		resp.Diagnostics.AddError(errMsgToggleServiceChannelFailed, err.Error())
+		return
+	}
+
+	if enabled {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Enabled service channel %d", id)})
+	} else {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Disabled service channel %d", id)})
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestActionServiceChannelToggle(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/action_service_channel_toggle")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ActionServiceChannelToggleWoEnabled("toggle", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "K8S", 51) + actionTrigger("toggle", "action.scc_service_channel_toggle.toggle"),
+				},
+				{
+					Config: providerConfig(user) + ActionServiceChannelToggleWoEnabled("toggle", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "K8S", 51) + actionTrigger("toggle", "action.scc_service_channel_toggle.toggle") + DataSourceSubaccountK8SServiceChannel("test", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", 51),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_subaccount_k8s_service_channel.test", "enabled", "true"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ActionServiceChannelToggle("enable", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "ABAPCloud", 52, true) + actionTrigger("enable", "action.scc_service_channel_toggle.enable"),
+				},
+				{
+					Config: providerConfig(user) + ActionServiceChannelToggle("enable", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "ABAPCloud", 52, true) + actionTrigger("enable", "action.scc_service_channel_toggle.enable") + DataSourceSubaccountABAPServiceChannel("test", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", 52),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_subaccount_abap_service_channel.test", "enabled", "true"),
+					),
+				},
+			},
+		})
+
+	})
+
+	t.Run("error path - ABAP Cloud service channel on unsupported connector version", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/action_service_channel_toggle_err_unsupported_version")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      providerConfig(user) + ActionServiceChannelToggle("enable", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "ABAPCloud", 52, true) + actionTrigger("enable", "action.scc_service_channel_toggle.enable"),
+					ExpectError: regexp.MustCompile(`(?s)The\s+value\s+ABAPCloud\s+of\s+the\s+attribute\s+type\s+of\s+the\s+action\s+scc_service_channel_toggle\s+requires\s+Cloud\s+Connector\s+version\s+2\.15\.0\s+or\s+higher,\s+but\s+the\s+connected\s+Cloud\s+Connector\s+has\s+version\s+2\.14\.0`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - id mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ActionServiceChannelToggleWoID("toggle", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "K8S"),
+					ExpectError: regexp.MustCompile(`The argument "id" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid type", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ActionServiceChannelToggleWoEnabled("toggle", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "HANA", 51) + actionTrigger("toggle", "action.scc_service_channel_toggle.toggle"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute type value must be one of:.*"K8S"`),
+				},
+			},
+		})
+	})
+
+}
+
+func ActionServiceChannelToggle(actionName string, regionHost string, subaccountID string, channelType string, id int64, enabled bool) string {
+	return fmt.Sprintf(`
+	action "scc_service_channel_toggle" "%s" {
+	config {
+	region_host = "%s"
+	subaccount = "%s"
+	type = "%s"
+	id = %d
+	enabled = %t
+	}
+	}
+	`, actionName, regionHost, subaccountID, channelType, id, enabled)
+}
+
+func ActionServiceChannelToggleWoEnabled(actionName string, regionHost string, subaccountID string, channelType string, id int64) string {
+	return fmt.Sprintf(`
+	action "scc_service_channel_toggle" "%s" {
+	config {
+	region_host = "%s"
+	subaccount = "%s"
+	type = "%s"
+	id = %d
+	}
+	}
+	`, actionName, regionHost, subaccountID, channelType, id)
+}
+
+func ActionServiceChannelToggleWoID(actionName string, regionHost string, subaccountID string, channelType string) string {
+	return fmt.Sprintf(`
+	action "scc_service_channel_toggle" "%s" {
+	config {
+	region_host = "%s"
+	subaccount = "%s"
+	type = "%s"
+	}
+	}
+	`, actionName, regionHost, subaccountID, channelType)
+}
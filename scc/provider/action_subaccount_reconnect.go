@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ action.ActionWithConfigure = &SubaccountReconnectAction{}
+
+func NewSubaccountReconnectAction() action.Action {
+	return &SubaccountReconnectAction{}
+}
+
+type SubaccountReconnectAction struct {
+	client *api.RestApiClient
+}
+
+func (a *SubaccountReconnectAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_reconnect"
+}
+
+func (a *SubaccountReconnectAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Subaccount Reconnect Action.
+
+Connects, disconnects or reconnects the tunnel of a subaccount, for example to recover from a broken connection, without changing the ` + "`tunnel.state`" + ` of the ` + "`scc_subaccount`" + ` resource.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount>`,
+		Attributes: map[string]schema.Attribute{
+			"region_host": schema.StringAttribute{
+				MarkdownDescription: "Region Host Name.",
+				Required:            true,
+			},
+			"subaccount": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"operation": schema.StringAttribute{
+				MarkdownDescription: "Operation that is executed for the tunnel. Defaults to `RECONNECT`. Possible values are:" +
+					getFormattedValueAsTableRow("operation", "description") +
+					getFormattedValueAsTableRow("---", "---") +
+					getFormattedValueAsTableRow("RECONNECT", "Disconnects the tunnel if it is connected and connects it again") +
+					getFormattedValueAsTableRow("CONNECT", "Connects the tunnel") +
+					getFormattedValueAsTableRow("DISCONNECT", "Disconnects the tunnel"),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("RECONNECT", "CONNECT", "DISCONNECT"),
+				},
+			},
+		},
+	}
+}
+
+func (a *SubaccountReconnectAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *SubaccountReconnectAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config SubaccountReconnectConfig
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regionHost := config.RegionHost.ValueString()
+	subaccount := config.Subaccount.ValueString()
+
+	operation := config.Operation.ValueString()
+	if operation == "" {
+		operation = "RECONNECT"
+	}
+
+	disconnect := operation == "DISCONNECT"
+	if operation == "RECONNECT" {
+		var respObj apiobjects.Subaccount
+		err := requestAndUnmarshal(a.client, &respObj, "GET", endpoints.GetSubaccountEndpoint(regionHost, subaccount), nil, true)
+		if err != nil {
+			resp.Diagnostics.AddError(errMsgFetchSubaccountTunnelFailed, err.Error())
+			return
+		}
+		disconnect = respObj.Tunnel.State == "Connected"
+	}
+
+	if disconnect {
+		if err := setSubaccountTunnelState(a.client, regionHost, subaccount, false); err != nil {
+			resp.Diagnostics.AddError(errMsgDisconnectSubaccountFailed, err.Error())
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Disconnected the tunnel of subaccount %s", subaccount)})
+	}
+
+	if operation == "DISCONNECT" {
+		return
+	}
+
+	if err := setSubaccountTunnelState(a.client, regionHost, subaccount, true); err != nil {
+		resp.Diagnostics.AddError(errMsgConnectSubaccountFailed, err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Connected the tunnel of subaccount %s", subaccount)})
+}
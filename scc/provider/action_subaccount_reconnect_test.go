@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestActionSubaccountReconnect(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/action_subaccount_reconnect")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ActionSubaccountReconnect("connect", "cf.eu12.hana.ondemand.com", "7480ee65-e039-41cf-ba72-6d9a0c7d2d4e", "CONNECT") + actionTrigger("connect", "action.scc_subaccount_reconnect.connect"),
+				},
+				{
+					Config: providerConfig(user) + ActionSubaccountReconnect("connect", "cf.eu12.hana.ondemand.com", "7480ee65-e039-41cf-ba72-6d9a0c7d2d4e", "CONNECT") + actionTrigger("connect", "action.scc_subaccount_reconnect.connect") + DataSourceSubaccountConfiguration("test", "cf.eu12.hana.ondemand.com", "7480ee65-e039-41cf-ba72-6d9a0c7d2d4e"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_subaccount_configuration.test", "tunnel.state", "Connected"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ActionSubaccountReconnect("disconnect", "cf.eu12.hana.ondemand.com", "7480ee65-e039-41cf-ba72-6d9a0c7d2d4e", "DISCONNECT") + actionTrigger("disconnect", "action.scc_subaccount_reconnect.disconnect"),
+				},
+				{
+					Config: providerConfig(user) + ActionSubaccountReconnect("disconnect", "cf.eu12.hana.ondemand.com", "7480ee65-e039-41cf-ba72-6d9a0c7d2d4e", "DISCONNECT") + actionTrigger("disconnect", "action.scc_subaccount_reconnect.disconnect") + DataSourceSubaccountConfiguration("test", "cf.eu12.hana.ondemand.com", "7480ee65-e039-41cf-ba72-6d9a0c7d2d4e"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_subaccount_configuration.test", "tunnel.state", "Disconnected"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ActionSubaccountReconnectWoOperation("reconnect", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671") + actionTrigger("reconnect", "action.scc_subaccount_reconnect.reconnect"),
+				},
+				{
+					Config: providerConfig(user) + ActionSubaccountReconnectWoOperation("reconnect", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671") + actionTrigger("reconnect", "action.scc_subaccount_reconnect.reconnect") + DataSourceSubaccountConfiguration("test", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_subaccount_configuration.test", "tunnel.state", "Connected"),
+					),
+				},
+			},
+		})
+
+	})
+
+	t.Run("error path - subaccount mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ActionSubaccountReconnectWoSubaccount("reconnect", "cf.eu12.hana.ondemand.com"),
+					ExpectError: regexp.MustCompile(`The argument "subaccount" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid subaccount", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ActionSubaccountReconnect("reconnect", "cf.eu12.hana.ondemand.com", "this-is-not-a-uuid", "RECONNECT") + actionTrigger("reconnect", "action.scc_subaccount_reconnect.reconnect"),
+					ExpectError: regexp.MustCompile(`Attribute subaccount value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid operation", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ActionSubaccountReconnect("reconnect", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "RESTART") + actionTrigger("reconnect", "action.scc_subaccount_reconnect.reconnect"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute operation value must be one of:.*"RECONNECT"`),
+				},
+			},
+		})
+	})
+
+}
+
+func ActionSubaccountReconnect(actionName string, regionHost string, subaccountID string, operation string) string {
+	return fmt.Sprintf(`
+	action "scc_subaccount_reconnect" "%s" {
+	config {
+	region_host = "%s"
+	subaccount = "%s"
+	operation = "%s"
+	}
+	}
+	`, actionName, regionHost, subaccountID, operation)
+}
+
+func ActionSubaccountReconnectWoOperation(actionName string, regionHost string, subaccountID string) string {
+	return fmt.Sprintf(`
+	action "scc_subaccount_reconnect" "%s" {
+	config {
+	region_host = "%s"
+	subaccount = "%s"
+	}
+	}
+	`, actionName, regionHost, subaccountID)
+}
+
+func ActionSubaccountReconnectWoSubaccount(actionName string, regionHost string) string {
+	return fmt.Sprintf(`
+	action "scc_subaccount_reconnect" "%s" {
+	config {
+	region_host = "%s"
+	}
+	}
+	`, actionName, regionHost)
+}
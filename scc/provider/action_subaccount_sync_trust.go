@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ action.ActionWithConfigure = &SubaccountSyncTrustAction{}
+
+func NewSubaccountSyncTrustAction() action.Action {
+	return &SubaccountSyncTrustAction{}
+}
+
+type SubaccountSyncTrustAction struct {
+	client *api.RestApiClient
+}
+
+func (a *SubaccountSyncTrustAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_sync_trust"
+}
+
+func (a *SubaccountSyncTrustAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Subaccount Sync Trust Action.
+
+Synchronizes the trust configuration of a subaccount with the cloud, for example after identity providers or applications were added to the trust configuration of the subaccount. The tunnel of the subaccount must be connected.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/set-up-trust-for-principal-propagation>`,
+		Attributes: map[string]schema.Attribute{
+			"region_host": schema.StringAttribute{
+				MarkdownDescription: "Region Host Name.",
+				Required:            true,
+			},
+			"subaccount": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+		},
+	}
+}
+
+func (a *SubaccountSyncTrustAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *SubaccountSyncTrustAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config SubaccountSyncTrustConfig
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := config.Subaccount.ValueString()
+
+	if err := syncSubaccountTrust(a.client, config.RegionHost.ValueString(), subaccount); err != nil {
+		resp.Diagnostics.AddError(errMsgSyncSubaccountTrustFailed, err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Synchronized the trust configuration of subaccount %s", subaccount)})
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestActionSubaccountSyncTrust(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/action_subaccount_sync_trust")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ActionSubaccountSyncTrust("sync", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671") + actionTrigger("sync", "action.scc_subaccount_sync_trust.sync"),
+				},
+			},
+		})
+
+	})
+
+	t.Run("error path - region host mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ActionSubaccountSyncTrustWoRegionHost("sync", "9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+					ExpectError: regexp.MustCompile(`The argument "region_host" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid subaccount", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ActionSubaccountSyncTrust("sync", "cf.eu12.hana.ondemand.com", "this-is-not-a-uuid") + actionTrigger("sync", "action.scc_subaccount_sync_trust.sync"),
+					ExpectError: regexp.MustCompile(`Attribute subaccount value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+}
+
+func ActionSubaccountSyncTrust(actionName string, regionHost string, subaccountID string) string {
+	return fmt.Sprintf(`
+	action "scc_subaccount_sync_trust" "%s" {
+	config {
+	region_host = "%s"
+	subaccount = "%s"
+	}
+	}
+	`, actionName, regionHost, subaccountID)
+}
+
+func ActionSubaccountSyncTrustWoRegionHost(actionName string, subaccountID string) string {
+	return fmt.Sprintf(`
+	action "scc_subaccount_sync_trust" "%s" {
+	config {
+	subaccount = "%s"
+	}
+	}
+	`, actionName, subaccountID)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/stretchr/testify/assert"
+)
+
+type testAction struct {
+	name      string
+	action    action.ActionWithConfigure
+	getClient func(action.Action) *api.RestApiClient
+}
+
+var actions = []testAction{
+	{
+		name:   "AlertMessagesAcknowledgeAction",
+		action: &AlertMessagesAcknowledgeAction{},
+		getClient: func(a action.Action) *api.RestApiClient {
+			return a.(*AlertMessagesAcknowledgeAction).client
+		},
+	},
+	{
+		name:   "SubaccountReconnectAction",
+		action: &SubaccountReconnectAction{},
+		getClient: func(a action.Action) *api.RestApiClient {
+			return a.(*SubaccountReconnectAction).client
+		},
+	},
+	{
+		name:   "SubaccountSyncTrustAction",
+		action: &SubaccountSyncTrustAction{},
+		getClient: func(a action.Action) *api.RestApiClient {
+			return a.(*SubaccountSyncTrustAction).client
+		},
+	},
+	{
+		name:   "ServiceChannelToggleAction",
+		action: &ServiceChannelToggleAction{},
+		getClient: func(a action.Action) *api.RestApiClient {
+			return a.(*ServiceChannelToggleAction).client
+		},
+	},
+}
+
+func TestAllActionConfigure(t *testing.T) {
+	mockClient := &api.RestApiClient{}
+
+	for _, ta := range actions {
+		t.Run(ta.name+"_nil_provider_data", func(t *testing.T) {
+			resp := &action.ConfigureResponse{}
+			ta.action.Configure(context.Background(), action.ConfigureRequest{ProviderData: nil}, resp)
+
+			assert.Nil(t, ta.getClient(ta.action), "Expected nil client for nil ProviderData")
+			assert.False(t, resp.Diagnostics.HasError(), "Expected no error for nil ProviderData")
+		})
+
+		t.Run(ta.name+"_invalid_provider_data", func(t *testing.T) {
+			resp := &action.ConfigureResponse{}
+			ta.action.Configure(context.Background(), action.ConfigureRequest{ProviderData: "invalid-type"}, resp)
+
+			assert.Nil(t, ta.getClient(ta.action), "Expected nil client for invalid ProviderData")
+			assert.True(t, resp.Diagnostics.HasError(), "Expected error for invalid ProviderData")
+		})
+
+		t.Run(ta.name+"_valid_provider_data", func(t *testing.T) {
+			resp := &action.ConfigureResponse{}
+			ta.action.Configure(context.Background(), action.ConfigureRequest{ProviderData: mockClient}, resp)
+
+			assert.Equal(t, mockClient, ta.getClient(ta.action), "Expected client to be set")
+			assert.False(t, resp.Diagnostics.HasError(), "Expected no error for valid ProviderData")
+		})
+	}
+}
@@ -86,6 +86,55 @@ var dataSources = []testDataSource{
 			return r.(*SubaccountK8SServiceChannelsDataSource).client
 		},
 	},
+	{
+		name:       "AlertMessagesDataSource",
+		datasource: &AlertMessagesDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*AlertMessagesDataSource).client
+		},
+	},
+	{
+		name:       "ConnectorVersionDataSource",
+		datasource: &ConnectorVersionDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*ConnectorVersionDataSource).client
+		},
+	},
+	{
+		name:       "MonitoringHardwareDataSource",
+		datasource: &MonitoringHardwareDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*MonitoringHardwareDataSource).client
+		},
+	},
+	{
+		name:       "MonitoringPerformanceDataSource",
+		datasource: &MonitoringPerformanceDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*MonitoringPerformanceDataSource).client
+		},
+	},
+	{
+		name:       "MonitoringTopTimeConsumersDataSource",
+		datasource: &MonitoringTopTimeConsumersDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*MonitoringTopTimeConsumersDataSource).client
+		},
+	},
+	{
+		name:       "SubaccountTunnelConnectionsDataSource",
+		datasource: &SubaccountTunnelConnectionsDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*SubaccountTunnelConnectionsDataSource).client
+		},
+	},
+	{
+		name:       "ConnectorConfigurationDataSource",
+		datasource: &ConnectorConfigurationDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*ConnectorConfigurationDataSource).client
+		},
+	},
 }
 
 func TestAllDataSourceConfigure(t *testing.T) {
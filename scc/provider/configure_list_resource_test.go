@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+type testListResource struct {
+	name         string
+	listResource list.ListResourceWithConfigure
+	getClient    func(list.ListResource) *api.RestApiClient
+}
+
+var listResources = []testListResource{
+	{
+		name:         "SubaccountListResource",
+		listResource: &SubaccountListResource{},
+		getClient: func(l list.ListResource) *api.RestApiClient {
+			return l.(*SubaccountListResource).client
+		},
+	},
+	{
+		name:         "SystemMappingListResource",
+		listResource: &SystemMappingListResource{},
+		getClient: func(l list.ListResource) *api.RestApiClient {
+			return l.(*SystemMappingListResource).client
+		},
+	},
+	{
+		name:         "SystemMappingResourceListResource",
+		listResource: &SystemMappingResourceListResource{},
+		getClient: func(l list.ListResource) *api.RestApiClient {
+			return l.(*SystemMappingResourceListResource).client
+		},
+	},
+	{
+		name:         "DomainMappingListResource",
+		listResource: &DomainMappingListResource{},
+		getClient: func(l list.ListResource) *api.RestApiClient {
+			return l.(*DomainMappingListResource).client
+		},
+	},
+	{
+		name:         "SubaccountK8SServiceChannelListResource",
+		listResource: &SubaccountK8SServiceChannelListResource{},
+		getClient: func(l list.ListResource) *api.RestApiClient {
+			return l.(*SubaccountK8SServiceChannelListResource).client
+		},
+	},
+	{
+		name:         "SubaccountABAPServiceChannelListResource",
+		listResource: &SubaccountABAPServiceChannelListResource{},
+		getClient: func(l list.ListResource) *api.RestApiClient {
+			return l.(*SubaccountABAPServiceChannelListResource).client
+		},
+	},
+}
+
+func TestAllListResourceConfigure(t *testing.T) {
+	mockClient := &api.RestApiClient{}
+
+	for _, tl := range listResources {
+		t.Run(tl.name+"_nil_provider_data", func(t *testing.T) {
+			resp := &resource.ConfigureResponse{}
+			tl.listResource.Configure(context.Background(), resource.ConfigureRequest{ProviderData: nil}, resp)
+
+			assert.Nil(t, tl.getClient(tl.listResource), "Expected nil client for nil ProviderData")
+			assert.False(t, resp.Diagnostics.HasError(), "Expected no error for nil ProviderData")
+		})
+
+		t.Run(tl.name+"_invalid_provider_data", func(t *testing.T) {
+			resp := &resource.ConfigureResponse{}
+			tl.listResource.Configure(context.Background(), resource.ConfigureRequest{ProviderData: "invalid-type"}, resp)
+
+			assert.Nil(t, tl.getClient(tl.listResource), "Expected nil client for invalid ProviderData")
+			assert.True(t, resp.Diagnostics.HasError(), "Expected error for invalid ProviderData")
+		})
+
+		t.Run(tl.name+"_valid_provider_data", func(t *testing.T) {
+			resp := &resource.ConfigureResponse{}
+			tl.listResource.Configure(context.Background(), resource.ConfigureRequest{ProviderData: mockClient}, resp)
+
+			assert.Equal(t, mockClient, tl.getClient(tl.listResource), "Expected client to be set")
+			assert.False(t, resp.Diagnostics.HasError(), "Expected no error for valid ProviderData")
+		})
+	}
+}
@@ -51,6 +51,69 @@ var resources = []testResource{
 			return r.(*SubaccountK8SServiceChannelResource).client
 		},
 	},
+	{
+		name:     "AlertingEmailResource",
+		resource: &AlertingEmailResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*AlertingEmailResource).client
+		},
+	},
+	{
+		name:     "AlertingSettingsResource",
+		resource: &AlertingSettingsResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*AlertingSettingsResource).client
+		},
+	},
+	{
+		name:     "SolutionManagementResource",
+		resource: &SolutionManagementResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*SolutionManagementResource).client
+		},
+	},
+	{
+		name:     "LDAPAuthenticationResource",
+		resource: &LDAPAuthenticationResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*LDAPAuthenticationResource).client
+		},
+	},
+	{
+		name:     "LocalUserResource",
+		resource: &LocalUserResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*LocalUserResource).client
+		},
+	},
+	{
+		name:     "SNCSettingsResource",
+		resource: &SNCSettingsResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*SNCSettingsResource).client
+		},
+	},
+	{
+		name:     "KerberosSettingsResource",
+		resource: &KerberosSettingsResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*KerberosSettingsResource).client
+		},
+	},
+	{
+		name:     "ProxySettingsResource",
+		resource: &ProxySettingsResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*ProxySettingsResource).client
+		},
+	},
+	{
+		name:     "ConnectorConfigurationResource",
+		resource: &ConnectorConfigurationResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*ConnectorConfigurationResource).client
+		},
+	},
 }
 
 func TestAllResourceConfigure(t *testing.T) {
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ datasource.DataSource = &AlertMessagesDataSource{}
+
+func NewAlertMessagesDataSource() datasource.DataSource {
+	return &AlertMessagesDataSource{}
+}
+
+type AlertMessagesDataSource struct {
+	client *api.RestApiClient
+}
+
+func (d *AlertMessagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_messages"
+}
+
+func (r *AlertMessagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Alert Messages Data Source.
+
+Lists the alert messages that are currently reported by the Cloud Connector. The data source can be combined with a ` + "`check`" + ` block or a postcondition to fail a pipeline if open alerts exist.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Display
+	* Support
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/alerting>`,
+		Attributes: map[string]schema.Attribute{
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "Only return alert messages of the given severity. Possible values are:" +
+					getFormattedValueAsTableRow("severity", "description") +
+					getFormattedValueAsTableRow("---", "---") +
+					getFormattedValueAsTableRow("ERROR", "Critical issue that requires immediate action") +
+					getFormattedValueAsTableRow("WARNING", "Potential issue that should be analyzed") +
+					getFormattedValueAsTableRow("INFO", "Informational message"),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ERROR", "WARNING", "INFO"),
+				},
+			},
+			"alert_messages": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the alert message.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the alert, for example `TUNNEL_DOWN`.",
+							Computed:            true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "Severity of the alert message.",
+							Computed:            true,
+						},
+						"timestamp": schema.Int64Attribute{
+							MarkdownDescription: "Timestamp of the occurrence of the alert.",
+							Computed:            true,
+						},
+						"region_host": schema.StringAttribute{
+							MarkdownDescription: "Region Host Name of the subaccount the alert refers to. Empty if the alert does not refer to a subaccount.",
+							Computed:            true,
+						},
+						"subaccount": schema.StringAttribute{
+							MarkdownDescription: "The ID of the subaccount the alert refers to. Empty if the alert does not refer to a subaccount.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Text of the alert message.",
+							Computed:            true,
+						},
+						"acknowledged": schema.BoolAttribute{
+							MarkdownDescription: "Boolean flag indicating whether the alert message has been acknowledged.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AlertMessagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AlertMessagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AlertMessagesConfig
+	var respObj apiobjects.AlertMessages
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := endpoints.GetAlertMessagesBaseEndpoint()
+
+	err := requestAndUnmarshal(d.client, &respObj.AlertMessages, "GET", endpoint, nil, true)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchAlertMessagesFailed, err.Error())
+		return
+	}
+
+	responseModel, err := AlertMessagesValueFrom(ctx, data, respObj)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgMapAlertMessagesFailed, fmt.Sprintf("%s", err))
+		return
+	}
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceAlertMessages(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/datasource_alert_messages")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceAlertMessages("messages"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("data.scc_alert_messages.messages", "severity"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.#", "3"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.id", "1"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.type", "TUNNEL_DOWN"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.severity", "ERROR"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.timestamp", "1760774400000"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.region_host", "cf.eu12.hana.ondemand.com"),
+						resource.TestMatchResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.subaccount", regexpValidUUID),
+						resource.TestCheckResourceAttrSet("data.scc_alert_messages.messages", "alert_messages.0.message"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.acknowledged", "false"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.1.severity", "WARNING"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.1.subaccount", ""),
+					),
+				},
+				{
+					Config: providerConfig(user) + DataSourceAlertMessagesBySeverity("messages", "ERROR"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "severity", "ERROR"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.#", "2"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.0.type", "TUNNEL_DOWN"),
+						resource.TestCheckResourceAttr("data.scc_alert_messages.messages", "alert_messages.1.type", "HIGH_MEMORY_USAGE"),
+					),
+				},
+			},
+		})
+
+	})
+
+	t.Run("error path - invalid severity", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      DataSourceAlertMessagesBySeverity("messages", "CRITICAL"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute severity value must be one of:.*"ERROR"`),
+				},
+			},
+		})
+	})
+
+}
+
+func DataSourceAlertMessages(datasourceName string) string {
+	return fmt.Sprintf(`
+	data "scc_alert_messages" "%s" {
+	}
+	`, datasourceName)
+}
+
+func DataSourceAlertMessagesBySeverity(datasourceName string, severity string) string {
+	return fmt.Sprintf(`
+	data "scc_alert_messages" "%s" {
+	severity = "%s"
+	}
+	`, datasourceName, severity)
+}
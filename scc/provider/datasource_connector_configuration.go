@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &ConnectorConfigurationDataSource{}
+
+func NewConnectorConfigurationDataSource() datasource.DataSource {
+	return &ConnectorConfigurationDataSource{}
+}
+
+type ConnectorConfigurationDataSource struct {
+	client *api.RestApiClient
+}
+
+func (d *ConnectorConfigurationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_configuration"
+}
+
+func (r *ConnectorConfigurationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Configuration Data Source.
+
+Provides the connector-wide advanced settings, such as timeouts, the size of the worker thread pool and the maximum payload size.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Display
+	* Support
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/configure-advanced-connectivity-settings>`,
+		Attributes: map[string]schema.Attribute{
+			"connection_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds for establishing connections to the backend systems.",
+				Computed:            true,
+			},
+			"min_thread_pool_size": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of worker threads that process requests to the backend systems.",
+				Computed:            true,
+			},
+			"max_thread_pool_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of worker threads that process requests to the backend systems.",
+				Computed:            true,
+			},
+			"max_payload_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size in MB of a single request or response payload.",
+				Computed:            true,
+			},
+			"allow_http_websocket_cloud": schema.BoolAttribute{
+				MarkdownDescription: "Whether HTTP connections on WebSocket from the cloud side are allowed.",
+				Computed:            true,
+			},
+			"allow_http_websocket_on_premise": schema.BoolAttribute{
+				MarkdownDescription: "Whether HTTP connections on WebSocket to the on-premise systems are allowed.",
+				Computed:            true,
+			},
+			"disable_ui": schema.BoolAttribute{
+				MarkdownDescription: "Whether the administration UI of the Cloud Connector is disabled.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ConnectorConfigurationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConnectorConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConnectorConfigurationConfig
+	var respObj apiobjects.ConnectorConfiguration
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := endpoints.GetConnectorConfigurationEndpoint()
+
+	err := requestAndUnmarshal(d.client, &respObj, "GET", endpoint, nil, true)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchConnectorConfigurationFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := ConnectorConfigurationValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapConnectorConfigurationFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceConnectorConfiguration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/datasource_connector_configuration")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceConnectorConfiguration("configuration"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_connector_configuration.configuration", "connection_timeout", "30"),
+						resource.TestCheckResourceAttr("data.scc_connector_configuration.configuration", "min_thread_pool_size", "10"),
+						resource.TestCheckResourceAttr("data.scc_connector_configuration.configuration", "max_thread_pool_size", "100"),
+						resource.TestCheckResourceAttr("data.scc_connector_configuration.configuration", "max_payload_size", "200"),
+						resource.TestCheckResourceAttr("data.scc_connector_configuration.configuration", "allow_http_websocket_cloud", "false"),
+						resource.TestCheckResourceAttr("data.scc_connector_configuration.configuration", "allow_http_websocket_on_premise", "false"),
+						resource.TestCheckResourceAttr("data.scc_connector_configuration.configuration", "disable_ui", "false"),
+					),
+				},
+			},
+		})
+
+	})
+
+}
+
+func DataSourceConnectorConfiguration(datasourceName string) string {
+	return fmt.Sprintf(`
+	data "scc_connector_configuration" "%s" {
+	}
+	`, datasourceName)
+}
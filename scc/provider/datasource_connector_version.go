@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &ConnectorVersionDataSource{}
+
+func NewConnectorVersionDataSource() datasource.DataSource {
+	return &ConnectorVersionDataSource{}
+}
+
+type ConnectorVersionDataSource struct {
+	client *api.RestApiClient
+}
+
+func (d *ConnectorVersionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_version"
+}
+
+func (r *ConnectorVersionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Version Data Source.
+
+Provides the version of the connected Cloud Connector instance, e.g. to make configurations depend on the availability of features.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+	* Display
+	* Support
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/connector-version>`,
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the Cloud Connector, e.g. `2.18.1`.",
+				Computed:            true,
+			},
+			"major": schema.Int64Attribute{
+				MarkdownDescription: "Major version of the Cloud Connector.",
+				Computed:            true,
+			},
+			"minor": schema.Int64Attribute{
+				MarkdownDescription: "Minor version of the Cloud Connector.",
+				Computed:            true,
+			},
+			"patch": schema.Int64Attribute{
+				MarkdownDescription: "Patch version of the Cloud Connector.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ConnectorVersionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConnectorVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConnectorVersionConfig
+	var respObj apiobjects.ConnectorVersion
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := endpoints.GetConnectorVersionEndpoint()
+
+	err := requestAndUnmarshal(d.client, &respObj, "GET", endpoint, nil, true)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchConnectorVersionFailed, err.Error())
+		return
+	}
+
+	responseModel, err := ConnectorVersionValueFrom(ctx, respObj)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgMapConnectorVersionFailed, fmt.Sprintf("%s", err))
+		return
+	}
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
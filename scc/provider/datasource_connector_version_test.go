@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceConnectorVersion(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/datasource_connector_version")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceConnectorVersion("version"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_connector_version.version", "version", "2.18.1"),
+						resource.TestCheckResourceAttr("data.scc_connector_version.version", "major", "2"),
+						resource.TestCheckResourceAttr("data.scc_connector_version.version", "minor", "18"),
+						resource.TestCheckResourceAttr("data.scc_connector_version.version", "patch", "1"),
+					),
+				},
+			},
+		})
+
+	})
+
+}
+
+func DataSourceConnectorVersion(datasourceName string) string {
+	return fmt.Sprintf(`
+	data "scc_connector_version" "%s" {
+	}
+	`, datasourceName)
+}
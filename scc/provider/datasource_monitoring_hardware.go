@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &MonitoringHardwareDataSource{}
+
+func NewMonitoringHardwareDataSource() datasource.DataSource {
+	return &MonitoringHardwareDataSource{}
+}
+
+type MonitoringHardwareDataSource struct {
+	client *api.RestApiClient
+}
+
+func (d *MonitoringHardwareDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitoring_hardware"
+}
+
+func (r *MonitoringHardwareDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Hardware Monitoring Data Source.
+
+Provides the current resource consumption of the host the Cloud Connector is running on.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Display
+	* Support
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/monitoring>`,
+		Attributes: map[string]schema.Attribute{
+			"cpu": schema.SingleNestedAttribute{
+				MarkdownDescription: "CPU usage of the Cloud Connector host.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"usage": schema.Float64Attribute{
+						MarkdownDescription: "CPU usage in percent.",
+						Computed:            true,
+					},
+					"cores": schema.Int64Attribute{
+						MarkdownDescription: "Number of available CPU cores.",
+						Computed:            true,
+					},
+				},
+			},
+			"memory":    monitoringCapacitySchema("Physical memory of the Cloud Connector host."),
+			"disk":      monitoringCapacitySchema("Disk space of the Cloud Connector installation."),
+			"java_heap": monitoringCapacitySchema("Java heap of the Cloud Connector process."),
+		},
+	}
+}
+
+func monitoringCapacitySchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "Total capacity in MB.",
+				Computed:            true,
+			},
+			"used": schema.Int64Attribute{
+				MarkdownDescription: "Used capacity in MB.",
+				Computed:            true,
+			},
+			"free": schema.Int64Attribute{
+				MarkdownDescription: "Free capacity in MB.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MonitoringHardwareDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MonitoringHardwareDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MonitoringHardwareConfig
+	var respObj apiobjects.MonitoringHardware
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := endpoints.GetMonitoringHardwareEndpoint()
+
+	err := requestAndUnmarshal(d.client, &respObj, "GET", endpoint, nil, true)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchMonitoringHardwareFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := MonitoringHardwareValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapMonitoringHardwareFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceMonitoringHardware(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/datasource_monitoring_hardware")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceMonitoringHardware("hardware"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_monitoring_hardware.hardware", "cpu.usage", "12.5"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_hardware.hardware", "cpu.cores", "8"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_hardware.hardware", "memory.total", "16384"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_hardware.hardware", "memory.used", "9216"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_hardware.hardware", "memory.free", "7168"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_hardware.hardware", "disk.total", "102400"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_hardware.hardware", "disk.free", "81920"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_hardware.hardware", "java_heap.total", "2048"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_hardware.hardware", "java_heap.used", "768"),
+					),
+				},
+			},
+		})
+
+	})
+
+}
+
+func DataSourceMonitoringHardware(datasourceName string) string {
+	return fmt.Sprintf(`
+	data "scc_monitoring_hardware" "%s" {
+	}
+	`, datasourceName)
+}
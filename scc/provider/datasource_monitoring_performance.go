@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ datasource.DataSource = &MonitoringPerformanceDataSource{}
+
+func NewMonitoringPerformanceDataSource() datasource.DataSource {
+	return &MonitoringPerformanceDataSource{}
+}
+
+type MonitoringPerformanceDataSource struct {
+	client *api.RestApiClient
+}
+
+func (d *MonitoringPerformanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitoring_performance"
+}
+
+func (r *MonitoringPerformanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Performance Monitoring Data Source.
+
+Provides the request durations of a subaccount, aggregated per backend system.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+	* Display
+	* Support
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/monitoring>`,
+		Attributes: map[string]schema.Attribute{
+			"region_host": schema.StringAttribute{
+				MarkdownDescription: "Region Host Name.",
+				Required:            true,
+			},
+			"subaccount": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"since_time_stamp": schema.Int64Attribute{
+				MarkdownDescription: "Timestamp of the start of the collection of the performance metrics.",
+				Computed:            true,
+			},
+			"backends": schema.ListNestedAttribute{
+				MarkdownDescription: "Performance metrics per backend system.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"virtual_host": schema.StringAttribute{
+							MarkdownDescription: "Virtual host used on the cloud side.",
+							Computed:            true,
+						},
+						"virtual_port": schema.StringAttribute{
+							MarkdownDescription: "Virtual port used on the cloud side.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol used when sending requests and receiving responses.",
+							Computed:            true,
+						},
+						"requests": schema.Int64Attribute{
+							MarkdownDescription: "Number of requests sent to the backend system.",
+							Computed:            true,
+						},
+						"average_duration": schema.Int64Attribute{
+							MarkdownDescription: "Average duration of the requests in milliseconds.",
+							Computed:            true,
+						},
+						"maximum_duration": schema.Int64Attribute{
+							MarkdownDescription: "Maximum duration of the requests in milliseconds.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MonitoringPerformanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MonitoringPerformanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MonitoringPerformanceConfig
+	var respObj apiobjects.MonitoringPerformance
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regionHost := data.RegionHost.ValueString()
+	subaccount := data.Subaccount.ValueString()
+	endpoint := endpoints.GetMonitoringPerformanceEndpoint(regionHost, subaccount)
+
+	err := requestAndUnmarshal(d.client, &respObj, "GET", endpoint, nil, true)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchMonitoringPerformanceFailed, err.Error())
+		return
+	}
+
+	responseModel, err := MonitoringPerformanceValueFrom(ctx, data, respObj)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgMapMonitoringPerformanceFailed, fmt.Sprintf("%s", err))
+		return
+	}
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
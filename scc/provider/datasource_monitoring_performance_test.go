@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceMonitoringPerformance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/datasource_monitoring_performance")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceMonitoringPerformance("performance", "cf.eu12.hana.ondemand.com", "304492be-5f0f-4bb0-8f59-c982107bc878"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_monitoring_performance.performance", "region_host", "cf.eu12.hana.ondemand.com"),
+						resource.TestMatchResourceAttr("data.scc_monitoring_performance.performance", "subaccount", regexpValidUUID),
+						resource.TestCheckResourceAttr("data.scc_monitoring_performance.performance", "since_time_stamp", "1760688000000"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_performance.performance", "backends.#", "2"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_performance.performance", "backends.0.virtual_host", "testterraformvirtual"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_performance.performance", "backends.0.virtual_port", "900"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_performance.performance", "backends.0.protocol", "HTTP"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_performance.performance", "backends.0.requests", "120"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_performance.performance", "backends.0.average_duration", "35"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_performance.performance", "backends.0.maximum_duration", "812"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_performance.performance", "backends.1.protocol", "RFC"),
+					),
+				},
+			},
+		})
+
+	})
+
+	t.Run("error path - region host mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      DataSourceMonitoringPerformanceWoRegionHost("performance", "304492be-5f0f-4bb0-8f59-c982107bc878"),
+					ExpectError: regexp.MustCompile(`The argument "region_host" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount id mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      DataSourceMonitoringPerformanceWoSubaccount("performance", "cf.eu12.hana.ondemand.com"),
+					ExpectError: regexp.MustCompile(`The argument "subaccount" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+}
+
+func DataSourceMonitoringPerformance(datasourceName string, regionHost string, subaccountID string) string {
+	return fmt.Sprintf(`
+	data "scc_monitoring_performance" "%s" {
+	region_host= "%s"
+    subaccount= "%s"
+	}
+	`, datasourceName, regionHost, subaccountID)
+}
+
+func DataSourceMonitoringPerformanceWoRegionHost(datasourceName string, subaccountID string) string {
+	return fmt.Sprintf(`
+	data "scc_monitoring_performance" "%s" {
+    subaccount= "%s"
+	}
+	`, datasourceName, subaccountID)
+}
+
+func DataSourceMonitoringPerformanceWoSubaccount(datasourceName string, regionHost string) string {
+	return fmt.Sprintf(`
+	data "scc_monitoring_performance" "%s" {
+	region_host= "%s"
+	}
+	`, datasourceName, regionHost)
+}
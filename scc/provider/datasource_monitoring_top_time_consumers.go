@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ datasource.DataSource = &MonitoringTopTimeConsumersDataSource{}
+
+func NewMonitoringTopTimeConsumersDataSource() datasource.DataSource {
+	return &MonitoringTopTimeConsumersDataSource{}
+}
+
+type MonitoringTopTimeConsumersDataSource struct {
+	client *api.RestApiClient
+}
+
+func (d *MonitoringTopTimeConsumersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitoring_top_time_consumers"
+}
+
+func (r *MonitoringTopTimeConsumersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Top Time Consumers Data Source.
+
+Provides the requests of a subaccount with the longest durations.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+	* Display
+	* Support
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/monitoring>`,
+		Attributes: map[string]schema.Attribute{
+			"region_host": schema.StringAttribute{
+				MarkdownDescription: "Region Host Name.",
+				Required:            true,
+			},
+			"subaccount": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"top_time_consumers": schema.ListNestedAttribute{
+				MarkdownDescription: "Requests with the longest durations, sorted by the total duration in descending order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"time_stamp": schema.Int64Attribute{
+							MarkdownDescription: "Timestamp of the start of the request.",
+							Computed:            true,
+						},
+						"virtual_host": schema.StringAttribute{
+							MarkdownDescription: "Virtual host used on the cloud side.",
+							Computed:            true,
+						},
+						"virtual_port": schema.StringAttribute{
+							MarkdownDescription: "Virtual port used on the cloud side.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol used when sending requests and receiving responses.",
+							Computed:            true,
+						},
+						"resource": schema.StringAttribute{
+							MarkdownDescription: "Resource, e.g. URL path or function module name, that was called.",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							MarkdownDescription: "User that sent the request.",
+							Computed:            true,
+						},
+						"total_duration": schema.Int64Attribute{
+							MarkdownDescription: "Total duration of the request in milliseconds.",
+							Computed:            true,
+						},
+						"backend_duration": schema.Int64Attribute{
+							MarkdownDescription: "Duration of the processing in the backend system in milliseconds.",
+							Computed:            true,
+						},
+						"sent_bytes": schema.Int64Attribute{
+							MarkdownDescription: "Number of bytes sent to the backend system.",
+							Computed:            true,
+						},
+						"received_bytes": schema.Int64Attribute{
+							MarkdownDescription: "Number of bytes received from the backend system.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MonitoringTopTimeConsumersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MonitoringTopTimeConsumersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MonitoringTopTimeConsumersConfig
+	var respObj apiobjects.MonitoringTopTimeConsumers
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regionHost := data.RegionHost.ValueString()
+	subaccount := data.Subaccount.ValueString()
+	endpoint := endpoints.GetMonitoringTopTimeConsumersEndpoint(regionHost, subaccount)
+
+	err := requestAndUnmarshal(d.client, &respObj.TopTimeConsumers, "GET", endpoint, nil, true)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchMonitoringTopTimeConsumersFailed, err.Error())
+		return
+	}
+
+	responseModel, err := MonitoringTopTimeConsumersValueFrom(ctx, data, respObj)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgMapMonitoringTopTimeConsumersFailed, fmt.Sprintf("%s", err))
+		return
+	}
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
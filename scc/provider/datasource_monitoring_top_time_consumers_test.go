@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceMonitoringTopTimeConsumers(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/datasource_monitoring_top_time_consumers")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceMonitoringTopTimeConsumers("consumers", "cf.eu12.hana.ondemand.com", "304492be-5f0f-4bb0-8f59-c982107bc878"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "region_host", "cf.eu12.hana.ondemand.com"),
+						resource.TestMatchResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "subaccount", regexpValidUUID),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.#", "2"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.0.time_stamp", "1760774400000"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.0.virtual_host", "testterraformrfc"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.0.virtual_port", "sapgw00"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.0.protocol", "RFC"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.0.resource", "BAPI_USER_GET_DETAIL"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.0.user", "TECH_USER"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.0.total_duration", "1390"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.0.backend_duration", "1320"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.0.sent_bytes", "2048"),
+						resource.TestCheckResourceAttr("data.scc_monitoring_top_time_consumers.consumers", "top_time_consumers.0.received_bytes", "65536"),
+					),
+				},
+			},
+		})
+
+	})
+
+	t.Run("error path - region host mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      DataSourceMonitoringTopTimeConsumersWoRegionHost("consumers", "304492be-5f0f-4bb0-8f59-c982107bc878"),
+					ExpectError: regexp.MustCompile(`The argument "region_host" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount id mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      DataSourceMonitoringTopTimeConsumersWoSubaccount("consumers", "cf.eu12.hana.ondemand.com"),
+					ExpectError: regexp.MustCompile(`The argument "subaccount" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+}
+
+func DataSourceMonitoringTopTimeConsumers(datasourceName string, regionHost string, subaccountID string) string {
+	return fmt.Sprintf(`
+	data "scc_monitoring_top_time_consumers" "%s" {
+	region_host= "%s"
+    subaccount= "%s"
+	}
+	`, datasourceName, regionHost, subaccountID)
+}
+
+func DataSourceMonitoringTopTimeConsumersWoRegionHost(datasourceName string, subaccountID string) string {
+	return fmt.Sprintf(`
+	data "scc_monitoring_top_time_consumers" "%s" {
+    subaccount= "%s"
+	}
+	`, datasourceName, subaccountID)
+}
+
+func DataSourceMonitoringTopTimeConsumersWoSubaccount(datasourceName string, regionHost string) string {
+	return fmt.Sprintf(`
+	data "scc_monitoring_top_time_consumers" "%s" {
+	region_host= "%s"
+	}
+	`, datasourceName, regionHost)
+}
@@ -140,6 +140,11 @@ func (d *SubaccountABAPServiceChannelDataSource) Read(ctx context.Context, req d
 		return
 	}
 
+	resp.Diagnostics.Append(checkConnectorCapabilities(ctx, d.client, "data source", "scc_subaccount_abap_service_channel", req.Config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	regionHost := data.RegionHost.ValueString()
 	subaccount := data.Subaccount.ValueString()
 	id := data.ID.ValueInt64()
@@ -147,6 +147,11 @@ func (d *SubaccountABAPServiceChannelsDataSource) Read(ctx context.Context, req
 		return
 	}
 
+	resp.Diagnostics.Append(checkConnectorCapabilities(ctx, d.client, "data source", "scc_subaccount_abap_service_channels", req.Config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	regionHost := data.RegionHost.ValueString()
 	subaccount := data.Subaccount.ValueString()
 
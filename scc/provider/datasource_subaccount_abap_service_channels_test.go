@@ -46,6 +46,22 @@ func TestDataSourceSubaccountABAPServiceChannels(t *testing.T) {
 
 	})
 
+	t.Run("error path - unsupported connector version", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/datasource_subaccount_abap_service_channels_err_unsupported_version")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config:      providerConfig(user) + DataSourceSubaccountABAPServiceChannels("scc_scs", regionHost, subaccount),
+					ExpectError: regexp.MustCompile(`(?s)The\s+data\s+source\s+scc_subaccount_abap_service_channels\s+requires\s+Cloud\s+Connector\s+version\s+2\.15\.0\s+or\s+higher,\s+but\s+the\s+connected\s+Cloud\s+Connector\s+has\s+version\s+2\.14\.0`),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - region host mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
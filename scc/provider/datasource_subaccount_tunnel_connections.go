@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ datasource.DataSource = &SubaccountTunnelConnectionsDataSource{}
+
+func NewSubaccountTunnelConnectionsDataSource() datasource.DataSource {
+	return &SubaccountTunnelConnectionsDataSource{}
+}
+
+type SubaccountTunnelConnectionsDataSource struct {
+	client *api.RestApiClient
+}
+
+func (d *SubaccountTunnelConnectionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_tunnel_connections"
+}
+
+func (r *SubaccountTunnelConnectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Subaccount Tunnel Connections Data Source.
+
+Lists the individual connections that are currently open via the tunnel of a subaccount. Compared to the aggregated connection counts of the subaccount tunnel, this helps to analyze which application uses which backend system.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+	* Display
+	* Support
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/monitoring>`,
+		Attributes: map[string]schema.Attribute{
+			"region_host": schema.StringAttribute{
+				MarkdownDescription: "Region Host Name.",
+				Required:            true,
+			},
+			"subaccount": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"application": schema.StringAttribute{
+				MarkdownDescription: "Only return the connections of the given application.",
+				Optional:            true,
+			},
+			"virtual_host": schema.StringAttribute{
+				MarkdownDescription: "Only return the connections to the given virtual host.",
+				Optional:            true,
+			},
+			"connections": schema.ListNestedAttribute{
+				MarkdownDescription: "Connections that are currently open.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"application": schema.StringAttribute{
+							MarkdownDescription: "Name of the application that opened the connection.",
+							Computed:            true,
+						},
+						"virtual_host": schema.StringAttribute{
+							MarkdownDescription: "Virtual host used on the cloud side.",
+							Computed:            true,
+						},
+						"virtual_port": schema.StringAttribute{
+							MarkdownDescription: "Virtual port used on the cloud side.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol used by the connection.",
+							Computed:            true,
+						},
+						"opened_since_time_stamp": schema.Int64Attribute{
+							MarkdownDescription: "Timestamp of the opening of the connection.",
+							Computed:            true,
+						},
+						"sent_bytes": schema.Int64Attribute{
+							MarkdownDescription: "Number of bytes sent to the backend system.",
+							Computed:            true,
+						},
+						"received_bytes": schema.Int64Attribute{
+							MarkdownDescription: "Number of bytes received from the backend system.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SubaccountTunnelConnectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SubaccountTunnelConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SubaccountTunnelConnectionsConfig
+	var respObj apiobjects.SubaccountTunnelConnections
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regionHost := data.RegionHost.ValueString()
+	subaccount := data.Subaccount.ValueString()
+	endpoint := endpoints.GetMonitoringTunnelConnectionsEndpoint(regionHost, subaccount)
+
+	err := requestAndUnmarshal(d.client, &respObj.Connections, "GET", endpoint, nil, true)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchTunnelConnectionsFailed, err.Error())
+		return
+	}
+
+	responseModel, err := SubaccountTunnelConnectionsValueFrom(ctx, data, respObj)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgMapTunnelConnectionsFailed, fmt.Sprintf("%s", err))
+		return
+	}
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceSubaccountTunnelConnections(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/datasource_subaccount_tunnel_connections")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceSubaccountTunnelConnections("connections", "cf.eu12.hana.ondemand.com", "304492be-5f0f-4bb0-8f59-c982107bc878"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "region_host", "cf.eu12.hana.ondemand.com"),
+						resource.TestMatchResourceAttr("data.scc_subaccount_tunnel_connections.connections", "subaccount", regexpValidUUID),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.#", "3"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.0.application", "businesspartner-app"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.0.virtual_host", "testterraformvirtual"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.0.virtual_port", "900"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.0.protocol", "HTTP"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.0.opened_since_time_stamp", "1760774400000"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.0.sent_bytes", "4096"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.0.received_bytes", "131072"),
+					),
+				},
+				{
+					Config: providerConfig(user) + DataSourceSubaccountTunnelConnectionsWithFilter("connections", "cf.eu12.hana.ondemand.com", "304492be-5f0f-4bb0-8f59-c982107bc878", "application", "businesspartner-app"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "application", "businesspartner-app"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.#", "2"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.0.virtual_host", "testterraformvirtual"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.1.virtual_host", "testterraformrfc"),
+					),
+				},
+				{
+					Config: providerConfig(user) + DataSourceSubaccountTunnelConnectionsWithFilter("connections", "cf.eu12.hana.ondemand.com", "304492be-5f0f-4bb0-8f59-c982107bc878", "virtual_host", "testterraformvirtual"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "virtual_host", "testterraformvirtual"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.#", "2"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.0.application", "businesspartner-app"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_tunnel_connections.connections", "connections.1.application", "sales-app"),
+					),
+				},
+			},
+		})
+
+	})
+
+	t.Run("error path - region host mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      DataSourceSubaccountTunnelConnectionsWoRegionHost("connections", "304492be-5f0f-4bb0-8f59-c982107bc878"),
+					ExpectError: regexp.MustCompile(`The argument "region_host" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount id mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      DataSourceSubaccountTunnelConnectionsWoSubaccount("connections", "cf.eu12.hana.ondemand.com"),
+					ExpectError: regexp.MustCompile(`The argument "subaccount" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+}
+
+func DataSourceSubaccountTunnelConnections(datasourceName string, regionHost string, subaccountID string) string {
+	return fmt.Sprintf(`
+	data "scc_subaccount_tunnel_connections" "%s" {
+	region_host= "%s"
+    subaccount= "%s"
+	}
+	`, datasourceName, regionHost, subaccountID)
+}
+
+func DataSourceSubaccountTunnelConnectionsWithFilter(datasourceName string, regionHost string, subaccountID string, filterAttribute string, filterValue string) string {
+	return fmt.Sprintf(`
+	data "scc_subaccount_tunnel_connections" "%s" {
+	region_host= "%s"
+    subaccount= "%s"
+	%s = "%s"
+	}
+	`, datasourceName, regionHost, subaccountID, filterAttribute, filterValue)
+}
+
+func DataSourceSubaccountTunnelConnectionsWoRegionHost(datasourceName string, subaccountID string) string {
+	return fmt.Sprintf(`
+	data "scc_subaccount_tunnel_connections" "%s" {
+    subaccount= "%s"
+	}
+	`, datasourceName, subaccountID)
+}
+
+func DataSourceSubaccountTunnelConnectionsWoSubaccount(datasourceName string, regionHost string) string {
+	return fmt.Sprintf(`
+	data "scc_subaccount_tunnel_connections" "%s" {
+	region_host= "%s"
+	}
+	`, datasourceName, regionHost)
+}
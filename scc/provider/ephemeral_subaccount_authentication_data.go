@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &SubaccountAuthenticationDataEphemeralResource{}
+
+func NewSubaccountAuthenticationDataEphemeralResource() ephemeral.EphemeralResource {
+	return &SubaccountAuthenticationDataEphemeralResource{
+		httpClient: &http.Client{Timeout: authenticationDataTimeout},
+	}
+}
+
+type SubaccountAuthenticationDataEphemeralResource struct {
+	httpClient *http.Client
+}
+
+func (r *SubaccountAuthenticationDataEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_authentication_data"
+}
+
+func (r *SubaccountAuthenticationDataEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Subaccount Authentication Data ephemeral resource.
+
+Reads the authentication data downloaded from a subaccount from a file, an environment variable or an HTTP endpoint such as a secret manager. The authentication data is validated and decoded without persisting it in the Terraform state, so that it can be passed to the write-only attribute ` + "`authentication_data_wo`" + ` of the ` + "`scc_subaccount_using_auth`" + ` resource.
+
+The authentication data is expected to be a base64 encoded JSON document containing the attributes ` + "`regionHost`" + `, ` + "`subaccount`" + ` and ` + "`expiresAt`" + ` (milliseconds since the epoch).
+
+Expired authentication data only results in a warning, as the ephemeral resource is opened on every plan, while the authentication data is only used when the subaccount is added. Use ` + "`expiry_time_stamp`" + ` to check the validity before adding a subaccount.
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount>`,
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path of the file containing the authentication data.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("file"), path.MatchRoot("environment_variable"), path.MatchRoot("url")),
+				},
+			},
+			"environment_variable": schema.StringAttribute{
+				MarkdownDescription: "Name of the environment variable containing the authentication data.",
+				Optional:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL from which the authentication data is fetched with a GET request. The response body must contain the authentication data.",
+				Optional:            true,
+			},
+			"http_headers": schema.MapAttribute{
+				MarkdownDescription: "Headers sent with the request to `url`, e.g. to authenticate against a secret manager.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.Map{
+					mapvalidator.AlsoRequires(path.MatchRoot("url")),
+				},
+			},
+			"authentication_data": schema.StringAttribute{
+				MarkdownDescription: "The authentication data, to be passed to the attribute `authentication_data_wo` of the `scc_subaccount_using_auth` resource.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"region_host": schema.StringAttribute{
+				MarkdownDescription: "Region host of the subaccount the authentication data was downloaded from.",
+				Computed:            true,
+			},
+			"subaccount": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount the authentication data was downloaded from.",
+				Computed:            true,
+			},
+			"expiry_time_stamp": schema.Int64Attribute{
+				MarkdownDescription: "Timestamp of the end of the validity of the authentication data.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SubaccountAuthenticationDataEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config SubaccountAuthenticationDataConfig
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var authenticationData string
+	var err error
+
+	switch {
+	case !config.File.IsNull():
+		var content []byte
+		content, err = os.ReadFile(config.File.ValueString())
+		authenticationData = string(content)
+	case !config.EnvironmentVariable.IsNull():
+		authenticationData, err = readAuthenticationDataFromEnv(config.EnvironmentVariable.ValueString())
+	default:
+		headers := map[string]string{}
+		resp.Diagnostics.Append(config.HTTPHeaders.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		authenticationData, err = readAuthenticationDataFromURL(ctx, r.httpClient, config.URL.ValueString(), headers)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgReadAuthenticationDataFailed, err.Error())
+		return
+	}
+
+	// Files and secret managers commonly append a line break to the value
+	authenticationData = strings.TrimSpace(authenticationData)
+
+	decoded, err := decodeAuthenticationData(authenticationData)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgInvalidAuthenticationData, err.Error())
+		return
+	}
+
+	if expiry := time.UnixMilli(decoded.ExpiresAt); !expiry.After(time.Now()) {
+		resp.Diagnostics.AddWarning(
+			"Expired Subaccount Authentication Data",
+			fmt.Sprintf("The authentication data expired at %s. It can no longer be used to add a subaccount, download new authentication data from the subaccount.", expiry.UTC().Format(time.RFC3339)),
+		)
+	}
+
+	result := SubaccountAuthenticationDataValueFrom(config, authenticationData, decoded)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, result)...)
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEphemeralSubaccountAuthenticationData(t *testing.T) {
+	t.Parallel()
+
+	authenticationData := encodeAuthenticationData("cf.eu12.hana.ondemand.com", "4916a705-273c-45a6-a2f0-08c234c7a23d", 4102444800000)
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/ephemeral_subaccount_authentication_data")
+		defer stopQuietly(rec)
+
+		file := filepath.Join(t.TempDir(), "authentication.data")
+		if err := os.WriteFile(file, []byte(authenticationData+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		envVar := "SCC_TEST_EPHEMERAL_AUTHENTICATION_DATA"
+		if err := os.Setenv(envVar, authenticationData); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Unsetenv(envVar)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(authenticationData))
+		}))
+		defer server.Close()
+
+		checks := resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("echo.test", "data.authentication_data", authenticationData),
+			resource.TestCheckResourceAttr("echo.test", "data.region_host", "cf.eu12.hana.ondemand.com"),
+			resource.TestCheckResourceAttr("echo.test", "data.subaccount", "4916a705-273c-45a6-a2f0-08c234c7a23d"),
+			resource.TestCheckResourceAttr("echo.test", "data.expiry_time_stamp", "4102444800000"),
+		)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getEchoTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + EphemeralSubaccountAuthenticationData("test", fmt.Sprintf("file = %q", file)),
+					Check:  checks,
+				},
+				{
+					Config: providerConfig(user) + EphemeralSubaccountAuthenticationData("test", fmt.Sprintf("environment_variable = %q", envVar)),
+					Check:  checks,
+				},
+				{
+					Config: providerConfig(user) + EphemeralSubaccountAuthenticationData("test", fmt.Sprintf("url = %q\n    http_headers = { Authorization = \"Bearer token\" }", server.URL)),
+					Check:  checks,
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - expired authentication data", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/ephemeral_subaccount_authentication_data_expired")
+		defer stopQuietly(rec)
+
+		// Expired authentication data only results in a warning, so that plans of already added subaccounts keep working
+		expiredAuthenticationData := encodeAuthenticationData("cf.eu12.hana.ondemand.com", "4916a705-273c-45a6-a2f0-08c234c7a23d", 946684800000)
+
+		file := filepath.Join(t.TempDir(), "authentication.data")
+		if err := os.WriteFile(file, []byte(expiredAuthenticationData), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getEchoTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + EphemeralSubaccountAuthenticationData("test", fmt.Sprintf("file = %q", file)),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("echo.test", "data.authentication_data", expiredAuthenticationData),
+						resource.TestCheckResourceAttr("echo.test", "data.expiry_time_stamp", "946684800000"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid authentication data", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/ephemeral_subaccount_authentication_data_err_invalid")
+		defer stopQuietly(rec)
+
+		file := filepath.Join(t.TempDir(), "authentication.data")
+		if err := os.WriteFile(file, []byte("not base64 encoded\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getEchoTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      providerConfig(user) + EphemeralSubaccountAuthenticationData("test", fmt.Sprintf("file = %q", file)),
+					ExpectError: regexp.MustCompile(`(?s)the authentication data is not base64 encoded`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - source mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getEchoTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      EphemeralSubaccountAuthenticationData("test", ""),
+					ExpectError: regexp.MustCompile(`(?s)No attribute specified when one \(and only one\) of\s+\[file,environment_variable,url\] is required`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - http headers without url", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getEchoTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      EphemeralSubaccountAuthenticationData("test", "file = \"authentication.data\"\n    http_headers = { Authorization = \"Bearer token\" }"),
+					ExpectError: regexp.MustCompile(`(?s)Attribute "url" must be specified when "http_headers" is specified`),
+				},
+			},
+		})
+	})
+}
+
+func EphemeralSubaccountAuthenticationData(resourceName, source string) string {
+	return fmt.Sprintf(`
+	ephemeral "scc_subaccount_authentication_data" "%[1]s" {
+    %[2]s
+	}
+
+	provider "echo" {
+    data = ephemeral.scc_subaccount_authentication_data.%[1]s
+	}
+
+	resource "echo" "%[1]s" {}
+	`, resourceName, source)
+}
+
+// getEchoTestProviders adds the echo provider, which exposes the values of ephemeral resources for the checks of a test step.
+func getEchoTestProviders(httpClient *http.Client) map[string]func() (tfprotov6.ProviderServer, error) {
+	providers := getTestProviders(httpClient)
+	providers["echo"] = echoprovider.NewProviderServer()
+	return providers
+}
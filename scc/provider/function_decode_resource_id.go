@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &DecodeResourceIDFunction{}
+
+func NewDecodeResourceIDFunction() function.Function {
+	return &DecodeResourceIDFunction{}
+}
+
+type DecodeResourceIDFunction struct{}
+
+func (f *DecodeResourceIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decode_resource_id"
+}
+
+func (f *DecodeResourceIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Decodes an encoded system mapping resource ID.",
+		MarkdownDescription: "Decodes a system mapping resource ID created by `encode_resource_id` or returned by the Cloud Connector API back to the URL path of the system mapping resource.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The encoded ID of the system mapping resource, e.g. `-api-v1`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DecodeResourceIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, DecodeResourceID(id)))
+}
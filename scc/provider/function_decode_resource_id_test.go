@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFunctionDecodeResourceID(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					output "root" {
+					value = provider::scc::decode_resource_id("-")
+					}
+					output "encoded_characters" {
+					value = provider::scc::decode_resource_id("-path+2Dwith+2Bspecial+2B2Dcharacters")
+					}
+					`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("root", "/"),
+						resource.TestCheckOutput("encoded_characters", "/path-with+special+2Dcharacters"),
+					),
+				},
+			},
+		})
+	})
+
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &EncodeResourceIDFunction{}
+
+func NewEncodeResourceIDFunction() function.Function {
+	return &EncodeResourceIDFunction{}
+}
+
+type EncodeResourceIDFunction struct{}
+
+func (f *EncodeResourceIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "encode_resource_id"
+}
+
+func (f *EncodeResourceIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Encodes the URL path of a system mapping resource.",
+		MarkdownDescription: `Encodes the URL path of a system mapping resource in the same way as the provider does for the Cloud Connector API, e.g. to build endpoint paths in modules.
+
+The following characters are replaced:
+- ` + "`+`" + ` with ` + "`+2B`" + `
+- ` + "`-`" + ` with ` + "`+2D`" + `
+- ` + "`/`" + ` with ` + "`-`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: "The URL path of the system mapping resource, e.g. `/api/v1`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EncodeResourceIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &path))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, CreateEncodedResourceID(path)))
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFunctionEncodeResourceID(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					output "encoded" {
+					value = provider::scc::encode_resource_id("/api/my-service+v1")
+					}
+					output "decoded" {
+					value = provider::scc::decode_resource_id(provider::scc::encode_resource_id("/api/my-service+v1"))
+					}
+					`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("encoded", "-api-my+2Dservice+2Bv1"),
+						resource.TestCheckOutput("decoded", "/api/my-service+v1"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - path mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					output "encoded" {
+					value = provider::scc::encode_resource_id()
+					}
+					`,
+					ExpectError: regexp.MustCompile(`Not enough function arguments`),
+				},
+			},
+		})
+	})
+
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseAuthenticationDataFunction{}
+
+var authenticationDataAttributeTypes = map[string]attr.Type{
+	"region_host": types.StringType,
+	"subaccount":  types.StringType,
+	"expires_at":  types.StringType,
+}
+
+func NewParseAuthenticationDataFunction() function.Function {
+	return &ParseAuthenticationDataFunction{}
+}
+
+type ParseAuthenticationDataFunction struct{}
+
+func (f *ParseAuthenticationDataFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_authentication_data"
+}
+
+func (f *ParseAuthenticationDataFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses the authentication data of a subaccount.",
+		MarkdownDescription: `Parses the authentication data downloaded from a subaccount and returns an object with the following attributes:
+- ` + "`region_host`" + `: Region host of the subaccount.
+- ` + "`subaccount`" + `: ID of the subaccount.
+- ` + "`expires_at`" + `: End of the validity of the authentication data as RFC 3339 timestamp.
+
+The function does not check whether the authentication data is expired, so that its result does not depend on the time of the evaluation. Use ` + "`timecmp`" + ` with ` + "`expires_at`" + ` to check the validity.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "blob",
+				MarkdownDescription: "The base64 encoded authentication data downloaded from the subaccount.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: authenticationDataAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseAuthenticationDataFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var blob string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &blob))
+	if resp.Error != nil {
+		return
+	}
+
+	decoded, err := decodeAuthenticationData(blob)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, diags := types.ObjectValue(authenticationDataAttributeTypes, map[string]attr.Value{
+		"region_host": types.StringValue(decoded.RegionHost),
+		"subaccount":  types.StringValue(decoded.Subaccount),
+		"expires_at":  types.StringValue(time.UnixMilli(decoded.ExpiresAt).UTC().Format(time.RFC3339)),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
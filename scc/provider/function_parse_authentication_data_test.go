@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFunctionParseAuthenticationData(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		// The expiry lies in the past, as the function does not check it
+		authenticationData := encodeAuthenticationData("cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", 1760774400000)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: FunctionParseAuthenticationData(authenticationData),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("region_host", "cf.eu12.hana.ondemand.com"),
+						resource.TestCheckOutput("subaccount", "9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+						resource.TestCheckOutput("expires_at", "2025-10-18T08:00:00Z"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid authentication data", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      FunctionParseAuthenticationData("not-base64"),
+					ExpectError: regexp.MustCompile(`the authentication data is not base64\s+encoded`),
+				},
+			},
+		})
+	})
+
+}
+
+func FunctionParseAuthenticationData(authenticationData string) string {
+	return fmt.Sprintf(`
+	locals {
+	authentication_data = provider::scc::parse_authentication_data("%s")
+	}
+	output "region_host" {
+	value = local.authentication_data.region_host
+	}
+	output "subaccount" {
+	value = local.authentication_data.subaccount
+	}
+	output "expires_at" {
+	value = local.authentication_data.expires_at
+	}
+	`, authenticationData)
+}
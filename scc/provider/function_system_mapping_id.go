@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &SystemMappingIDFunction{}
+
+func NewSystemMappingIDFunction() function.Function {
+	return &SystemMappingIDFunction{}
+}
+
+type SystemMappingIDFunction struct{}
+
+func (f *SystemMappingIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "system_mapping_id"
+}
+
+func (f *SystemMappingIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds the identifier of a system mapping.",
+		MarkdownDescription: "Builds the identifier of a system mapping in the format `virtual_host:virtual_port`, which the Cloud Connector API uses to address the system mapping.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "host",
+				MarkdownDescription: "Virtual host used on the cloud side.",
+			},
+			function.StringParameter{
+				Name:                "port",
+				MarkdownDescription: "Virtual port used on the cloud side.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SystemMappingIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host, port string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &host, &port))
+	if resp.Error != nil {
+		return
+	}
+
+	if host == "" || port == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("host and port of the system mapping must not be empty"))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, fmt.Sprintf("%s:%s", host, port)))
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+)
+
+// authenticationDataTimeout limits the time for fetching the authentication data from a URL.
+const authenticationDataTimeout = 30 * time.Second
+
+// subaccountAuthenticationData is the decoded content of the authentication data downloaded from a subaccount.
+type subaccountAuthenticationData struct {
+	RegionHost string `json:"regionHost"`
+	Subaccount string `json:"subaccount"`
+	// ExpiresAt is the end of the validity of the authentication data in milliseconds since the epoch
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+// decodeAuthenticationData decodes the base64 encoded authentication data. The expiry is not checked, so that the result does not depend
+// on the time of the evaluation.
+func decodeAuthenticationData(authenticationData string) (subaccountAuthenticationData, error) {
+	var decoded subaccountAuthenticationData
+
+	content, err := base64.StdEncoding.DecodeString(authenticationData)
+	if err != nil {
+		return decoded, fmt.Errorf("the authentication data is not base64 encoded: %w", err)
+	}
+
+	if err := json.Unmarshal(content, &decoded); err != nil {
+		return decoded, fmt.Errorf("the authentication data does not contain a valid JSON document: %w", err)
+	}
+
+	if decoded.RegionHost == "" {
+		return decoded, fmt.Errorf("the authentication data does not contain a region host")
+	}
+
+	if !uuidvalidator.UuidRegexp.MatchString(decoded.Subaccount) {
+		return decoded, fmt.Errorf("the authentication data does not contain a valid subaccount ID, got: %q", decoded.Subaccount)
+	}
+
+	if decoded.ExpiresAt == 0 {
+		return decoded, fmt.Errorf("the authentication data does not contain an expiry")
+	}
+
+	return decoded, nil
+}
+
+// readAuthenticationDataFromEnv returns the authentication data stored in the given environment variable.
+func readAuthenticationDataFromEnv(name string) (string, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok || strings.TrimSpace(value) == "" {
+		return "", fmt.Errorf("the environment variable %s is not set", name)
+	}
+
+	return value, nil
+}
+
+// readAuthenticationDataFromURL fetches the authentication data with a GET request, e.g. from a secret manager.
+func readAuthenticationDataFromURL(ctx context.Context, httpClient *http.Client, url string, headers map[string]string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	response, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetching the authentication data from %s failed with status %s", url, response.Status)
+	}
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
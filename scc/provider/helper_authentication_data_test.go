@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAuthenticationData(t *testing.T) {
+	tests := []struct {
+		description        string
+		authenticationData string
+		expectedError      string
+		expected           subaccountAuthenticationData
+	}{
+		{
+			description:        "happy path",
+			authenticationData: encodeAuthenticationData("cf.eu12.hana.ondemand.com", "4916a705-273c-45a6-a2f0-08c234c7a23d", 1750000300000),
+			expected: subaccountAuthenticationData{
+				RegionHost: "cf.eu12.hana.ondemand.com",
+				Subaccount: "4916a705-273c-45a6-a2f0-08c234c7a23d",
+				ExpiresAt:  1750000300000,
+			},
+		},
+		{
+			description:        "error path - not base64 encoded",
+			authenticationData: "not base64 encoded",
+			expectedError:      "the authentication data is not base64 encoded",
+		},
+		{
+			description:        "error path - no JSON document",
+			authenticationData: base64.StdEncoding.EncodeToString([]byte("regionHost=cf.eu12.hana.ondemand.com")),
+			expectedError:      "the authentication data does not contain a valid JSON document",
+		},
+		{
+			description:        "error path - region host missing",
+			authenticationData: encodeAuthenticationData("", "4916a705-273c-45a6-a2f0-08c234c7a23d", 1750000300000),
+			expectedError:      "the authentication data does not contain a region host",
+		},
+		{
+			description:        "error path - invalid subaccount",
+			authenticationData: encodeAuthenticationData("cf.eu12.hana.ondemand.com", "subaccount", 1750000300000),
+			expectedError:      `the authentication data does not contain a valid subaccount ID, got: "subaccount"`,
+		},
+		{
+			description:        "error path - expiry missing",
+			authenticationData: encodeAuthenticationData("cf.eu12.hana.ondemand.com", "4916a705-273c-45a6-a2f0-08c234c7a23d", 0),
+			expectedError:      "the authentication data does not contain an expiry",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			decoded, err := decodeAuthenticationData(test.authenticationData)
+
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, decoded)
+		})
+	}
+}
+
+func TestReadAuthenticationDataFromURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("authentication data"))
+	}))
+	defer server.Close()
+
+	t.Run("happy path", func(t *testing.T) {
+		authenticationData, err := readAuthenticationDataFromURL(context.Background(), server.Client(), server.URL, map[string]string{"Authorization": "Bearer token"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "authentication data", authenticationData)
+	})
+
+	t.Run("error path - unexpected status", func(t *testing.T) {
+		_, err := readAuthenticationDataFromURL(context.Background(), server.Client(), server.URL, nil)
+
+		assert.EqualError(t, err, fmt.Sprintf("fetching the authentication data from %s failed with status 401 Unauthorized", server.URL))
+	})
+}
+
+func encodeAuthenticationData(regionHost, subaccount string, expiresAt int64) string {
+	content := fmt.Sprintf(`{"regionHost":"%s","subaccount":"%s","expiresAt":%d}`, regionHost, subaccount, expiresAt)
+	return base64.StdEncoding.EncodeToString([]byte(content))
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// connectorCapability describes a resource, data source, list resource or action, or an attribute of it, that is only available as of a
+// given Cloud Connector version.
+type connectorCapability struct {
+	// Attribute restricts the capability to a top-level attribute. The whole type is gated if it is empty.
+	Attribute string
+	// Value restricts the capability to a value of the string attribute, e.g. a service channel type. Any configured value is gated if it is empty.
+	Value          string
+	MinimumVersion string
+}
+
+// connectorCapabilities maps the type names to the Cloud Connector versions they depend on. Resources, data sources, list resources and
+// actions of the same type name share the entry.
+//
+// An entry only takes effect where the type calls checkConnectorCapabilities: resources from ModifyPlan via validateConnectorCapabilities,
+// actions from ModifyPlan, and data sources and list resources before their first request. A new entry needs this call in every type
+// of that name.
+var connectorCapabilities = map[string][]connectorCapability{
+	"scc_subaccount_using_auth": {
+		{Attribute: "authentication_data", MinimumVersion: "2.17.0"},
+		{Attribute: "authentication_data_wo", MinimumVersion: "2.17.0"},
+	},
+	"scc_subaccount_abap_service_channel": {
+		{MinimumVersion: "2.15.0"},
+	},
+	"scc_subaccount_abap_service_channels": {
+		{MinimumVersion: "2.15.0"},
+	},
+	"scc_service_channel_toggle": {
+		{Attribute: "type", Value: "ABAPCloud", MinimumVersion: "2.15.0"},
+	},
+}
+
+// validateConnectorCapabilities rejects a planned resource if the connected Cloud Connector does not support the resource or one of its configured attributes.
+// Resources call it from ModifyPlan, as the version of the Cloud Connector is not known during the validation of the configuration.
+func validateConnectorCapabilities(ctx context.Context, client *api.RestApiClient, typeName string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destroying a resource must always be possible
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkConnectorCapabilities(ctx, client, "resource", typeName, req.Config)...)
+}
+
+// checkConnectorCapabilities returns an error diagnostic for every capability of the type that is used by the configuration, but not supported
+// by the connected Cloud Connector. The kind of the type, e.g. "data source", is used in the diagnostics.
+func checkConnectorCapabilities(ctx context.Context, client *api.RestApiClient, kind string, typeName string, config attributeGetter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, capability := range connectorCapabilities[typeName] {
+		if capability.Attribute == "" {
+			if err := requireConnectorVersion(client, "The "+kind+" "+typeName, capability.MinimumVersion); err != nil {
+				diags.AddError(errMsgUnsupportedConnectorVersion, err.Error())
+			}
+			continue
+		}
+
+		var value attr.Value
+		attributePath := path.Root(capability.Attribute)
+		diags.Append(config.GetAttribute(ctx, attributePath, &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if value == nil || value.IsNull() {
+			continue
+		}
+
+		feature := "The attribute " + capability.Attribute + " of the " + kind + " " + typeName
+		if capability.Value != "" {
+			// An unknown value is checked again during the apply
+			if stringValue, ok := value.(types.String); !ok || stringValue.IsUnknown() || stringValue.ValueString() != capability.Value {
+				continue
+			}
+			feature = "The value " + capability.Value + " of the attribute " + capability.Attribute + " of the " + kind + " " + typeName
+		}
+
+		if err := requireConnectorVersion(client, feature, capability.MinimumVersion); err != nil {
+			diags.AddAttributeError(attributePath, errMsgUnsupportedConnectorVersion, err.Error())
+		}
+	}
+
+	return diags
+}
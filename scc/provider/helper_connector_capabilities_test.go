@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// configValues provides the top-level attributes of a configuration for checkConnectorCapabilities.
+type configValues map[string]attr.Value
+
+func (c configValues) GetAttribute(ctx context.Context, attributePath path.Path, target any) diag.Diagnostics {
+	*(target.(*attr.Value)) = c[attributePath.String()]
+	return nil
+}
+
+func TestCheckConnectorCapabilities(t *testing.T) {
+	olderVersion := &api.ConnectorVersion{Major: 2, Minor: 14, Patch: 0}
+
+	tests := []struct {
+		description string
+		version     *api.ConnectorVersion
+		kind        string
+		typeName    string
+		config      configValues
+		expectsErr  string
+	}{
+		{
+			description: "happy path - type without capabilities",
+			version:     olderVersion,
+			kind:        "resource",
+			typeName:    "scc_subaccount",
+		},
+		{
+			description: "happy path - supported type",
+			version:     &api.ConnectorVersion{Major: 2, Minor: 15, Patch: 0},
+			kind:        "data source",
+			typeName:    "scc_subaccount_abap_service_channels",
+		},
+		{
+			description: "happy path - attribute not configured",
+			version:     olderVersion,
+			kind:        "resource",
+			typeName:    "scc_subaccount_using_auth",
+			config:      configValues{"authentication_data": types.StringNull(), "authentication_data_wo": types.StringNull()},
+		},
+		{
+			description: "happy path - other attribute value",
+			version:     olderVersion,
+			kind:        "action",
+			typeName:    "scc_service_channel_toggle",
+			config:      configValues{"type": types.StringValue("K8S")},
+		},
+		{
+			description: "happy path - unknown attribute value",
+			version:     olderVersion,
+			kind:        "action",
+			typeName:    "scc_service_channel_toggle",
+			config:      configValues{"type": types.StringUnknown()},
+		},
+		{
+			description: "error path - unsupported type",
+			version:     olderVersion,
+			kind:        "list resource",
+			typeName:    "scc_subaccount_abap_service_channel",
+			expectsErr:  "The list resource scc_subaccount_abap_service_channel requires Cloud Connector version 2.15.0 or higher, but the connected Cloud Connector has version 2.14.0",
+		},
+		{
+			description: "error path - unsupported attribute",
+			version:     olderVersion,
+			kind:        "resource",
+			typeName:    "scc_subaccount_using_auth",
+			config:      configValues{"authentication_data": types.StringValue("data"), "authentication_data_wo": types.StringNull()},
+			expectsErr:  "The attribute authentication_data of the resource scc_subaccount_using_auth requires Cloud Connector version 2.17.0 or higher, but the connected Cloud Connector has version 2.14.0",
+		},
+		{
+			description: "error path - unsupported attribute value",
+			version:     olderVersion,
+			kind:        "action",
+			typeName:    "scc_service_channel_toggle",
+			config:      configValues{"type": types.StringValue("ABAPCloud")},
+			expectsErr:  "The value ABAPCloud of the attribute type of the action scc_service_channel_toggle requires Cloud Connector version 2.15.0 or higher, but the connected Cloud Connector has version 2.14.0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			client := &api.RestApiClient{ConnectorVersion: test.version}
+
+			diags := checkConnectorCapabilities(context.Background(), client, test.kind, test.typeName, test.config)
+
+			if test.expectsErr == "" {
+				assert.False(t, diags.HasError())
+			} else {
+				assert.Len(t, diags.Errors(), 1)
+				assert.Equal(t, test.expectsErr, diags.Errors()[0].Detail())
+			}
+		})
+	}
+}
+
+// TestConnectorCapabilities_TypeNames makes sure that the capabilities refer to types of the provider, so that a misspelled type name does
+// not silently disable the check.
+func TestConnectorCapabilities_TypeNames(t *testing.T) {
+	ctx := context.Background()
+	p := New()
+	typeNames := map[string]bool{}
+
+	for _, resourceFunc := range p.Resources(ctx) {
+		var resp resource.MetadataResponse
+		resourceFunc().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "scc"}, &resp)
+		typeNames[resp.TypeName] = true
+	}
+
+	for _, dataSourceFunc := range p.DataSources(ctx) {
+		var resp datasource.MetadataResponse
+		dataSourceFunc().Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "scc"}, &resp)
+		typeNames[resp.TypeName] = true
+	}
+
+	for _, actionFunc := range p.(provider.ProviderWithActions).Actions(ctx) {
+		var resp action.MetadataResponse
+		actionFunc().Metadata(ctx, action.MetadataRequest{ProviderTypeName: "scc"}, &resp)
+		typeNames[resp.TypeName] = true
+	}
+
+	for typeName := range connectorCapabilities {
+		assert.True(t, typeNames[typeName], "unknown type name %s", typeName)
+	}
+}
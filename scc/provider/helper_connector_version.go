@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+)
+
+// requireConnectorVersion returns an error if the connected Cloud Connector is older than the given minimum version.
+// If the version of the Cloud Connector is unknown, the check is skipped and the Cloud Connector decides.
+func requireConnectorVersion(client *api.RestApiClient, feature string, minimum string) error {
+	if client == nil || client.ConnectorVersion == nil {
+		return nil
+	}
+
+	minimumVersion := api.MustParseConnectorVersion(minimum)
+	if client.ConnectorVersion.AtLeast(minimumVersion) {
+		return nil
+	}
+
+	return fmt.Errorf("%s requires Cloud Connector version %s or higher, but the connected Cloud Connector has version %s", feature, minimumVersion, client.ConnectorVersion)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireConnectorVersion(t *testing.T) {
+	tests := []struct {
+		description string
+		version     *api.ConnectorVersion
+		minimum     string
+		expectsErr  string
+	}{
+		{
+			description: "happy path - connector version is higher than the minimum",
+			version:     &api.ConnectorVersion{Major: 2, Minor: 18, Patch: 1},
+			minimum:     "2.17.0",
+		},
+		{
+			description: "happy path - connector version equals the minimum",
+			version:     &api.ConnectorVersion{Major: 2, Minor: 17, Patch: 0},
+			minimum:     "2.17.0",
+		},
+		{
+			description: "happy path - unknown connector version skips the check",
+			version:     nil,
+			minimum:     "2.17.0",
+		},
+		{
+			description: "error path - connector version is lower than the minimum",
+			version:     &api.ConnectorVersion{Major: 2, Minor: 16, Patch: 2},
+			minimum:     "2.17.0",
+			expectsErr:  "Feature requires Cloud Connector version 2.17.0 or higher, but the connected Cloud Connector has version 2.16.2",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			client := &api.RestApiClient{ConnectorVersion: test.version}
+
+			err := requireConnectorVersion(client, "Feature", test.minimum)
+
+			if test.expectsErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectsErr)
+			}
+		})
+	}
+}
@@ -8,3 +8,30 @@ import (
 func getFormattedValueAsTableRow(val string, description string) string {
 	return fmt.Sprintf("\n  | %s | %s | ", strings.ReplaceAll(val, "|", "\\|"), strings.ReplaceAll(description, "|", "\\|"))
 }
+
+// docValue is an allowed value of an attribute together with its description.
+// Alternative spellings of the same value are separated by a slash, e.g. "internal/INTERNAL".
+type docValue struct {
+	value       string
+	description string
+}
+
+// getFormattedValuesAsTable formats the allowed values as a markdown table with the given column headers.
+func getFormattedValuesAsTable(valueHeader string, descriptionHeader string, values []docValue) string {
+	table := getFormattedValueAsTableRow(valueHeader, descriptionHeader) + getFormattedValueAsTableRow("---", "---")
+	for _, v := range values {
+		table += getFormattedValueAsTableRow(v.value, v.description)
+	}
+
+	return table
+}
+
+// getAllowedValues returns the allowed values including all alternative spellings, e.g. for a OneOf validator.
+func getAllowedValues(values []docValue) []string {
+	allowed := []string{}
+	for _, v := range values {
+		allowed = append(allowed, strings.Split(v.value, "/")...)
+	}
+
+	return allowed
+}
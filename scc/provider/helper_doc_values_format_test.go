@@ -58,3 +58,23 @@ func TestToGetFormattedValue(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFormattedValuesAsTable(t *testing.T) {
+	values := []docValue{
+		{value: "HTTP", description: "HTTP protocol"},
+		{value: "internal/INTERNAL", description: "Internal host"},
+	}
+
+	t.Run("happy path - formats the headers and the values as a markdown table", func(t *testing.T) {
+		expects := "\n  | value | description | " +
+			"\n  | --- | --- | " +
+			"\n  | HTTP | HTTP protocol | " +
+			"\n  | internal/INTERNAL | Internal host | "
+
+		assert.Equal(t, expects, getFormattedValuesAsTable("value", "description", values))
+	})
+
+	t.Run("happy path - returns all spellings of the values", func(t *testing.T) {
+		assert.Equal(t, []string{"HTTP", "internal", "INTERNAL"}, getAllowedValues(values))
+	})
+}
@@ -2,13 +2,14 @@ package provider
 
 const (
 	// Subaccount
-	errMsgAddSubaccountFailed    = "error creating the cloud connector subaccount."
-	errMsgFetchSubaccountFailed  = "error fetching the cloud connector subaccount"
-	errMsgFetchSubaccountsFailed = "error fetching the cloud connector subaccounts"
-	errMsgUpdateSubaccountFailed = "error updating the cloud connector subaccount."
-	errMsgDeleteSubaccountFailed = "error deleting the cloud connector subaccount"
-	errMsgMapSubaccountFailed    = "error mapping the cloud connector subaccount value"
-	errMsgMapSubaccountsFailed   = "error mapping the cloud connector subaccounts value"
+	errMsgAddSubaccountFailed                = "error creating the cloud connector subaccount."
+	errMsgFetchSubaccountFailed              = "error fetching the cloud connector subaccount"
+	errMsgFetchSubaccountsFailed             = "error fetching the cloud connector subaccounts"
+	errMsgUpdateSubaccountFailed             = "error updating the cloud connector subaccount."
+	errMsgDeleteSubaccountFailed             = "error deleting the cloud connector subaccount"
+	errMsgMapSubaccountFailed                = "error mapping the cloud connector subaccount value"
+	errMsgMapSubaccountsFailed               = "error mapping the cloud connector subaccounts value"
+	errMsgRefreshSubaccountCertificateFailed = "error refreshing the cloud connector subaccount certificate"
 
 	// System Mapping
 	errMsgAddSystemMappingFailed    = "error creating the cloud connector system mapping"
@@ -27,6 +28,7 @@ const (
 	errMsgDeleteSystemMappingResourceFailed = "error deleting the cloud connector system mapping resource"
 	errMsgMapSystemMappingResourceFailed    = "error mapping the cloud connector system mapping resource value"
 	errMsgMapSystemMappingResourcesFailed   = "error mapping the cloud connector system mapping resources value"
+	warnMsgSystemMappingNotChecked          = "cloud connector system mapping resource not checked"
 
 	// Domain Mapping
 	errMsgAddDomainMappingFailed    = "error creating the cloud connector domain mapping"
@@ -56,4 +58,111 @@ const (
 	errMsgDeleteSubaccountABAPServiceChannelFailed = "error deleting the cloud connector subaccount ABAP service channel"
 	errMsgMapSubaccountABAPServiceChannelFailed    = "error mapping the cloud connector subaccount ABAP service channel value"
 	errMsgMapSubaccountABAPServiceChannelsFailed   = "error mapping the cloud connector subaccount ABAP service channels value"
+
+	// Alerting E-Mail Configuration
+	errMsgAddAlertingEmailFailed      = "error creating the cloud connector alerting e-mail configuration"
+	errMsgFetchAlertingEmailFailed    = "error fetching the cloud connector alerting e-mail configuration"
+	errMsgUpdateAlertingEmailFailed   = "error updating the cloud connector alerting e-mail configuration"
+	errMsgDeleteAlertingEmailFailed   = "error deleting the cloud connector alerting e-mail configuration"
+	errMsgMapAlertingEmailFailed      = "error mapping the cloud connector alerting e-mail configuration value"
+	errMsgSendAlertingTestEmailFailed = "error sending the cloud connector alerting test e-mail"
+
+	// Alerting Settings
+	errMsgAddAlertingSettingsFailed    = "error creating the cloud connector alerting settings"
+	errMsgFetchAlertingSettingsFailed  = "error fetching the cloud connector alerting settings"
+	errMsgUpdateAlertingSettingsFailed = "error updating the cloud connector alerting settings"
+	errMsgDeleteAlertingSettingsFailed = "error deleting the cloud connector alerting settings"
+	errMsgMapAlertingSettingsFailed    = "error mapping the cloud connector alerting settings value"
+
+	// Alert Messages
+	errMsgFetchAlertMessagesFailed      = "error fetching the cloud connector alert messages"
+	errMsgMapAlertMessagesFailed        = "error mapping the cloud connector alert messages value"
+	errMsgAcknowledgeAlertMessageFailed = "error acknowledging the cloud connector alert message"
+	errMsgDeleteAlertMessageFailed      = "error deleting the cloud connector alert message"
+
+	// Connector Version
+	errMsgFetchConnectorVersionFailed = "error fetching the cloud connector version"
+	errMsgMapConnectorVersionFailed   = "error mapping the cloud connector version value"
+	errMsgUnsupportedConnectorVersion = "unsupported cloud connector version"
+
+	// Monitoring
+	errMsgFetchMonitoringHardwareFailed         = "error fetching the cloud connector hardware metrics"
+	errMsgMapMonitoringHardwareFailed           = "error mapping the cloud connector hardware metrics value"
+	errMsgFetchMonitoringPerformanceFailed      = "error fetching the cloud connector performance metrics"
+	errMsgMapMonitoringPerformanceFailed        = "error mapping the cloud connector performance metrics value"
+	errMsgFetchMonitoringTopTimeConsumersFailed = "error fetching the cloud connector top time consumers"
+	errMsgMapMonitoringTopTimeConsumersFailed   = "error mapping the cloud connector top time consumers value"
+	errMsgFetchTunnelConnectionsFailed          = "error fetching the cloud connector subaccount tunnel connections"
+	errMsgMapTunnelConnectionsFailed            = "error mapping the cloud connector subaccount tunnel connections value"
+
+	// Solution Management
+	errMsgAddSolutionManagementFailed                  = "error creating the cloud connector solution management configuration"
+	errMsgFetchSolutionManagementFailed                = "error fetching the cloud connector solution management configuration"
+	errMsgUpdateSolutionManagementFailed               = "error updating the cloud connector solution management configuration"
+	errMsgDeleteSolutionManagementFailed               = "error deleting the cloud connector solution management configuration"
+	errMsgMapSolutionManagementFailed                  = "error mapping the cloud connector solution management configuration value"
+	errMsgDownloadSolutionManagementRegistrationFailed = "error downloading the cloud connector solution management registration file"
+
+	// LDAP Authentication
+	errMsgAddLDAPAuthenticationFailed    = "error creating the cloud connector LDAP authentication configuration"
+	errMsgFetchLDAPAuthenticationFailed  = "error fetching the cloud connector LDAP authentication configuration"
+	errMsgUpdateLDAPAuthenticationFailed = "error updating the cloud connector LDAP authentication configuration"
+	errMsgDeleteLDAPAuthenticationFailed = "error deleting the cloud connector LDAP authentication configuration"
+	errMsgMapLDAPAuthenticationFailed    = "error mapping the cloud connector LDAP authentication configuration value"
+	errMsgTestLDAPAuthenticationFailed   = "error testing the connection to the LDAP server"
+
+	// Local User
+	errMsgUpdateLocalUserPasswordFailed = "error changing the cloud connector local user password"
+	errMsgFetchLocalUserFailed          = "error fetching the cloud connector local user"
+	errMsgMapLocalUserFailed            = "error mapping the cloud connector local user value"
+	errMsgDeleteLocalUserFailed         = "error deleting the cloud connector local user"
+
+	// SNC Settings
+	errMsgAddSNCSettingsFailed    = "error creating the cloud connector SNC settings"
+	errMsgFetchSNCSettingsFailed  = "error fetching the cloud connector SNC settings"
+	errMsgUpdateSNCSettingsFailed = "error updating the cloud connector SNC settings"
+	errMsgDeleteSNCSettingsFailed = "error deleting the cloud connector SNC settings"
+	errMsgMapSNCSettingsFailed    = "error mapping the cloud connector SNC settings value"
+	warnMsgSNCNotConfigured       = "SNC is not configured on the cloud connector"
+
+	// Kerberos Settings
+	errMsgAddKerberosSettingsFailed    = "error creating the cloud connector kerberos settings"
+	errMsgFetchKerberosSettingsFailed  = "error fetching the cloud connector kerberos settings"
+	errMsgUpdateKerberosSettingsFailed = "error updating the cloud connector kerberos settings"
+	errMsgDeleteKerberosSettingsFailed = "error deleting the cloud connector kerberos settings"
+	errMsgMapKerberosSettingsFailed    = "error mapping the cloud connector kerberos settings value"
+
+	// Proxy Settings
+	errMsgAddProxySettingsFailed          = "error creating the cloud connector proxy settings"
+	errMsgFetchProxySettingsFailed        = "error fetching the cloud connector proxy settings"
+	errMsgUpdateProxySettingsFailed       = "error updating the cloud connector proxy settings"
+	errMsgDeleteProxySettingsFailed       = "error deleting the cloud connector proxy settings"
+	errMsgMapProxySettingsFailed          = "error mapping the cloud connector proxy settings value"
+	errMsgFetchConnectedSubaccountsFailed = "error fetching the connected cloud connector subaccounts"
+	warnMsgReconnectSubaccountFailed      = "cloud connector subaccount could not be reconnected"
+
+	// Connector Configuration
+	errMsgAddConnectorConfigurationFailed    = "error creating the cloud connector configuration"
+	errMsgFetchConnectorConfigurationFailed  = "error fetching the cloud connector configuration"
+	errMsgUpdateConnectorConfigurationFailed = "error updating the cloud connector configuration"
+	errMsgDeleteConnectorConfigurationFailed = "error resetting the cloud connector configuration"
+	errMsgMapConnectorConfigurationFailed    = "error mapping the cloud connector configuration value"
+
+	// Singleton Resources
+	errMsgImportSingletonFailed = "error importing the cloud connector settings"
+	warnMsgSingletonNotReset    = "cloud connector settings not reset"
+
+	// Subaccount Authentication Data
+	errMsgReadAuthenticationDataFailed = "error reading the subaccount authentication data"
+	errMsgInvalidAuthenticationData    = "invalid subaccount authentication data"
+
+	// Subaccount Actions
+	errMsgFetchSubaccountTunnelFailed = "error fetching the cloud connector subaccount tunnel state"
+	errMsgConnectSubaccountFailed     = "error connecting the cloud connector subaccount tunnel"
+	errMsgDisconnectSubaccountFailed  = "error disconnecting the cloud connector subaccount tunnel"
+	errMsgSyncSubaccountTrustFailed   = "error synchronizing the cloud connector subaccount trust configuration"
+
+	// Service Channel Actions
+	errMsgFetchServiceChannelFailed  = "error fetching the cloud connector subaccount service channel"
+	errMsgToggleServiceChannelFailed = "error toggling the cloud connector subaccount service channel"
 )
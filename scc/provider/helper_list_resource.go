@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// listResource is embedded by the list resources, which enumerate the objects of a Cloud Connector instance for `terraform query`.
+type listResource struct {
+	client *api.RestApiClient
+}
+
+func (l *listResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = client
+}
+
+// subaccountFilterAttributes returns the attributes to restrict a list resource to the subaccounts of a region or a single subaccount.
+// All subaccounts of the Cloud Connector are listed if no filter is configured.
+func subaccountFilterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"region_host": schema.StringAttribute{
+			MarkdownDescription: "Region Host Name. Only the subaccounts of this region are listed.",
+			Optional:            true,
+		},
+		"subaccount": schema.StringAttribute{
+			MarkdownDescription: "The ID of the subaccount. Only this subaccount is listed.",
+			Optional:            true,
+			Validators: []validator.String{
+				uuidvalidator.ValidUUID(),
+				stringvalidator.AlsoRequires(path.MatchRoot("region_host")),
+			},
+		},
+	}
+}
+
+// listSubaccounts returns the subaccounts matching the filter of a list resource.
+func listSubaccounts(client *api.RestApiClient, filter SubaccountListConfig) ([]apiobjects.Subaccounts, error) {
+	var respObj apiobjects.SubaccountsDataSource
+	if err := requestAndUnmarshal(client, &respObj.Subaccounts, "GET", endpoints.GetSubaccountBaseEndpoint(), nil, true); err != nil {
+		return nil, err
+	}
+
+	subaccounts := []apiobjects.Subaccounts{}
+	for _, subaccount := range respObj.Subaccounts {
+		if !filter.RegionHost.IsNull() && subaccount.RegionHost != filter.RegionHost.ValueString() {
+			continue
+		}
+		if !filter.Subaccount.IsNull() && subaccount.Subaccount != filter.Subaccount.ValueString() {
+			continue
+		}
+		subaccounts = append(subaccounts, subaccount)
+	}
+
+	return subaccounts, nil
+}
+
+// newListResult returns the list result for a listed object. The identity is taken from the resource data,
+// which Terraform only receives if it requested the resource data.
+func newListResult(ctx context.Context, req list.ListRequest, displayName string, model any) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	setIdentity(ctx, result.Resource, result.Identity, &result.Diagnostics)
+
+	return result
+}
+
+// listResultError returns a list result that only reports an error, which ends the listing.
+func listResultError(summary string, err error) list.ListResult {
+	return list.ListResult{
+		Diagnostics: diag.Diagnostics{diag.NewErrorDiagnostic(summary, err.Error())},
+	}
+}
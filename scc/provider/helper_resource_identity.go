@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// attributeGetter is implemented by the state of a resource as well as by the resource data of a list result.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target any) diag.Diagnostics
+}
+
+// setIdentity sets the resource identity from the attributes of the same name. The identity attributes are always a subset
+// of the resource attributes, as they are the attributes that identify the resource on the Cloud Connector.
+func setIdentity(ctx context.Context, data attributeGetter, identity *tfsdk.ResourceIdentity, diagnostics *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+
+	for name := range identity.Schema.GetAttributes() {
+		var value attr.Value
+		diagnostics.Append(data.GetAttribute(ctx, path.Root(name), &value)...)
+		if diagnostics.HasError() {
+			return
+		}
+
+		diagnostics.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// importStateFromIdentity sets the attributes of the state from the identity if the import block specifies an identity instead of
+// an import identifier. It returns false if an import identifier is given, which is then parsed by the resource.
+func importStateFromIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) bool {
+	if req.ID != "" || req.Identity == nil {
+		return false
+	}
+
+	for name := range req.Identity.Schema.GetAttributes() {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(name), &value)...)
+		if resp.Diagnostics.HasError() {
+			return true
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	return true
+}
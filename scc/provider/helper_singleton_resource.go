@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// singletonResource is embedded by resources that manage a connector-wide settings object instead of a collection.
+// It provides the lifecycle shared by these resources:
+//   - Create and Update adopt the existing settings, attributes that are not configured keep their current value
+//   - Delete resets the settings to their documented defaults, or only removes the resource from the state with a warning
+//     if the settings cannot be reset
+//   - Import needs no identifier, as there is only one settings object per Cloud Connector instance. For the same reason
+//     these resources have no resource identity.
+type singletonResource[T any] struct {
+	client *api.RestApiClient
+	// endpoint of the settings object, supporting GET and PUT as well as DELETE if resettable
+	endpoint string
+	// description of the settings used in diagnostics, e.g. "SNC settings"
+	description string
+	// resettable is true if a DELETE on the endpoint restores the documented defaults
+	resettable bool
+	// importAttribute is set from the fetched settings on import, the subsequent read populates all other attributes
+	importAttribute string
+	importValue     func(T) any
+}
+
+func (s *singletonResource[T]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+// fetch returns the current settings.
+func (s *singletonResource[T]) fetch() (T, error) {
+	var respObj T
+	err := requestAndUnmarshal(s.client, &respObj, "GET", s.endpoint, nil, true)
+	return respObj, err
+}
+
+// adopt merges the request body into the current settings, so that settings missing in the body are kept.
+// Attributes that are not configured are unknown in the plan, so the request body leaves them out and they keep their
+// current value. Write-only attributes are always null in the plan, so the resources take them from the configuration
+// when building the request body.
+func (s *singletonResource[T]) adopt(planBody map[string]any) (map[string]any, error) {
+	current, err := s.fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedBody := map[string]any{}
+	if err := json.Unmarshal(currentJSON, &mergedBody); err != nil {
+		return nil, err
+	}
+
+	// The request body is normalized to JSON types, so that nested objects can be merged as well
+	planJSON, err := json.Marshal(planBody)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedBody := map[string]any{}
+	if err := json.Unmarshal(planJSON, &normalizedBody); err != nil {
+		return nil, err
+	}
+
+	mergeRequestBody(mergedBody, normalizedBody)
+
+	return mergedBody, nil
+}
+
+// mergeRequestBody copies the values of src into dst. Nested objects are merged, so that their missing keys are kept.
+func mergeRequestBody(dst map[string]any, src map[string]any) {
+	for key, value := range src {
+		srcObject, srcIsObject := value.(map[string]any)
+		dstObject, dstIsObject := dst[key].(map[string]any)
+		if srcIsObject && dstIsObject {
+			mergeRequestBody(dstObject, srcObject)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// write stores the settings and returns them as stored by the Cloud Connector.
+func (s *singletonResource[T]) write(planBody map[string]any) (T, error) {
+	var respObj T
+	if err := requestAndUnmarshal(s.client, &respObj, "PUT", s.endpoint, planBody, false); err != nil {
+		return respObj, err
+	}
+
+	return s.fetch()
+}
+
+// reset restores the documented defaults. Settings that cannot be reset are left untouched with a warning.
+func (s *singletonResource[T]) reset(diagnostics *diag.Diagnostics, errMsg string) {
+	if !s.resettable {
+		diagnostics.AddWarning(warnMsgSingletonNotReset, fmt.Sprintf("The %s cannot be reset and keep their current value on the Cloud Connector. The resource was only removed from the Terraform state.", s.description))
+		return
+	}
+
+	var respObj T
+	if err := requestAndUnmarshal(s.client, &respObj, "DELETE", s.endpoint, nil, false); err != nil {
+		diagnostics.AddError(errMsg, err.Error())
+	}
+}
+
+func (s *singletonResource[T]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// There is only one settings object, so the import identifier is not evaluated
+	respObj, err := s.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgImportSingletonFailed, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(s.importAttribute), s.importValue(respObj))...)
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingletonResourceAdopt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"libraryPath":"/usr/sap/scc/libsapcrypto.so","myName":"p:CN=SCC","qop":"Privacy"}`))
+	}))
+	defer server.Close()
+
+	baseURL, _ := url.Parse(server.URL)
+	singleton := singletonResource[apiobjects.SNCSettings]{
+		client:   &api.RestApiClient{Client: server.Client(), BaseURL: baseURL},
+		endpoint: "/snc",
+	}
+
+	planBody, err := singleton.adopt(map[string]any{"myName": "p:CN=SCC2"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"libraryPath": "/usr/sap/scc/libsapcrypto.so",
+		"myName":      "p:CN=SCC2",
+		"qop":         "Privacy",
+	}, planBody)
+}
+
+func TestSingletonResourceReset(t *testing.T) {
+	tests := []struct {
+		description    string
+		resettable     bool
+		responseStatus int
+		expectsDelete  bool
+		expectsWarns   int
+		expectsError   bool
+	}{
+		{
+			description:    "happy path - settings are reset",
+			resettable:     true,
+			responseStatus: http.StatusNoContent,
+			expectsDelete:  true,
+		},
+		{
+			description:  "warning - settings cannot be reset",
+			resettable:   false,
+			expectsWarns: 1,
+		},
+		{
+			description:    "error path - reset fails",
+			resettable:     true,
+			responseStatus: http.StatusInternalServerError,
+			expectsDelete:  true,
+			expectsError:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			deleted := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				deleted = r.Method == http.MethodDelete
+				w.WriteHeader(test.responseStatus)
+			}))
+			defer server.Close()
+
+			baseURL, _ := url.Parse(server.URL)
+			singleton := singletonResource[apiobjects.SNCSettings]{
+				client:      &api.RestApiClient{Client: server.Client(), BaseURL: baseURL},
+				endpoint:    "/snc",
+				description: "SNC settings",
+				resettable:  test.resettable,
+			}
+
+			var diagnostics diag.Diagnostics
+			singleton.reset(&diagnostics, errMsgDeleteSNCSettingsFailed)
+
+			assert.Equal(t, test.expectsDelete, deleted)
+			assert.Equal(t, test.expectsError, diagnostics.HasError())
+			assert.Equal(t, test.expectsWarns, diagnostics.WarningsCount())
+		})
+	}
+}
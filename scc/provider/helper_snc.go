@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// warnIfSNCNotConfigured adds a warning if a system mapping uses secure RFC while SNC is not configured on the Cloud Connector.
+// The check runs on apply, so that an scc_snc_settings resource the system mapping depends on has already been created.
+func warnIfSNCNotConfigured(client *api.RestApiClient, protocol string, diagnostics *diag.Diagnostics) {
+	if protocol != "RFCS" {
+		return
+	}
+
+	var respObj apiobjects.SNCSettings
+	err := requestAndUnmarshal(client, &respObj, "GET", endpoints.GetSNCSettingsEndpoint(), nil, true)
+	if err != nil {
+		// The check is best effort and must not fail the system mapping itself
+		return
+	}
+
+	if respObj.LibraryPath == "" || respObj.MyName == "" {
+		diagnostics.AddWarning(warnMsgSNCNotConfigured, "The system mapping uses the protocol RFCS, but SNC is not configured on the Cloud Connector. Connections to the backend will fail until the SNC library path and SNC name are set, e.g. with the scc_snc_settings resource.")
+	}
+}
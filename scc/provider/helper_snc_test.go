@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarnIfSNCNotConfigured(t *testing.T) {
+	tests := []struct {
+		description  string
+		protocol     string
+		sncResponse  string
+		expectsWarns int
+	}{
+		{
+			description: "happy path - protocol without SNC is not checked",
+			protocol:    "RFC",
+			sncResponse: `{"libraryPath":"","myName":"","qop":"Maximum"}`,
+		},
+		{
+			description: "happy path - SNC is configured",
+			protocol:    "RFCS",
+			sncResponse: `{"libraryPath":"/usr/sap/scc/libsapcrypto.so","myName":"p:CN=SCC","qop":"Maximum"}`,
+		},
+		{
+			description:  "warning - SNC is not configured",
+			protocol:     "RFCS",
+			sncResponse:  `{"libraryPath":"","myName":"","qop":"Maximum"}`,
+			expectsWarns: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(test.sncResponse))
+			}))
+			defer server.Close()
+
+			baseURL, _ := url.Parse(server.URL)
+			client := &api.RestApiClient{Client: server.Client(), BaseURL: baseURL}
+
+			var diagnostics diag.Diagnostics
+			warnIfSNCNotConfigured(client, test.protocol, &diagnostics)
+
+			assert.False(t, diagnostics.HasError())
+			assert.Equal(t, test.expectsWarns, diagnostics.WarningsCount())
+		})
+	}
+}
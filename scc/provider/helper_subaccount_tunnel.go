@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// connectedSubaccounts returns the subaccounts whose tunnel is currently connected.
+func connectedSubaccounts(client *api.RestApiClient) ([]apiobjects.Subaccount, error) {
+	var subaccounts []apiobjects.Subaccounts
+	err := requestAndUnmarshal(client, &subaccounts, "GET", endpoints.GetSubaccountBaseEndpoint(), nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	connected := []apiobjects.Subaccount{}
+	for _, subaccount := range subaccounts {
+		var respObj apiobjects.Subaccount
+		err = requestAndUnmarshal(client, &respObj, "GET", endpoints.GetSubaccountEndpoint(subaccount.RegionHost, subaccount.Subaccount), nil, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if respObj.Tunnel.State == "Connected" {
+			connected = append(connected, respObj)
+		}
+	}
+
+	return connected, nil
+}
+
+// reconnectSubaccounts disconnects and connects the tunnels of the given subaccounts again.
+// The tunnels may only drop some time after the change, so the reconnect does not depend on the state reported right
+// after it. Failures are reported as warnings, as the change that interrupted the tunnels has already been applied.
+func reconnectSubaccounts(client *api.RestApiClient, subaccounts []apiobjects.Subaccount, diagnostics *diag.Diagnostics) {
+	for _, subaccount := range subaccounts {
+		err := setSubaccountTunnelState(client, subaccount.RegionHost, subaccount.Subaccount, false)
+		if err == nil {
+			err = setSubaccountTunnelState(client, subaccount.RegionHost, subaccount.Subaccount, true)
+		}
+
+		if err != nil {
+			diagnostics.AddWarning(warnMsgReconnectSubaccountFailed, fmt.Sprintf("The tunnel of subaccount %s in region %s could not be reconnected: %s", subaccount.Subaccount, subaccount.RegionHost, err))
+		}
+	}
+}
+
+// setSubaccountTunnelState connects or disconnects the tunnel of a subaccount.
+func setSubaccountTunnelState(client *api.RestApiClient, regionHost, subaccount string, connected bool) error {
+	var respObj apiobjects.Subaccount
+	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount) + "/state"
+
+	return requestAndUnmarshal(client, &respObj, "PUT", endpoint, map[string]string{"connected": fmt.Sprintf("%t", connected)}, false)
+}
+
+// syncSubaccountTrust triggers the synchronization of the trust configuration of a subaccount with the cloud.
+func syncSubaccountTrust(client *api.RestApiClient, regionHost, subaccount string) error {
+	var respObj apiobjects.Subaccount
+	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount) + "/trust"
+
+	return requestAndUnmarshal(client, &respObj, "POST", endpoint, nil, false)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &DomainMappingListResource{}
+
+func NewDomainMappingListResource() list.ListResource {
+	return &DomainMappingListResource{}
+}
+
+type DomainMappingListResource struct {
+	listResource
+}
+
+func (l *DomainMappingListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_mapping"
+}
+
+func (l *DomainMappingListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the domain mappings of the Cloud Connector subaccounts for the generation of import blocks with `terraform query`.",
+		Attributes:          subaccountFilterAttributes(),
+	}
+}
+
+func (l *DomainMappingListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config SubaccountListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		subaccounts, err := listSubaccounts(l.client, config)
+		if err != nil {
+			push(listResultError(errMsgFetchSubaccountsFailed, err))
+			return
+		}
+
+		for _, subaccount := range subaccounts {
+			var respObj apiobjects.DomainMappings
+			endpoint := endpoints.GetDomainMappingBaseEndpoint(subaccount.RegionHost, subaccount.Subaccount)
+
+			if err := requestAndUnmarshal(l.client, &respObj.DomainMappings, "GET", endpoint, nil, true); err != nil {
+				push(listResultError(errMsgFetchDomainMappingsFailed, err))
+				return
+			}
+
+			parent := DomainMappingConfig{
+				RegionHost: types.StringValue(subaccount.RegionHost),
+				Subaccount: types.StringValue(subaccount.Subaccount),
+			}
+
+			for _, mapping := range respObj.DomainMappings {
+				model, err := DomainMappingValueFrom(ctx, parent, mapping)
+				if err != nil {
+					push(listResultError(errMsgMapDomainMappingFailed, err))
+					return
+				}
+
+				displayName := fmt.Sprintf("%s -> %s (%s)", mapping.VirtualDomain, mapping.InternalDomain, subaccount.Subaccount)
+				if !push(newListResult(ctx, req, displayName, model)) {
+					return
+				}
+			}
+		}
+	}
+}
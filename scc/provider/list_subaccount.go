@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ list.ListResourceWithConfigure = &SubaccountListResource{}
+
+func NewSubaccountListResource() list.ListResource {
+	return &SubaccountListResource{}
+}
+
+type SubaccountListResource struct {
+	listResource
+}
+
+func (l *SubaccountListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount"
+}
+
+func (l *SubaccountListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Lists the subaccounts of the Cloud Connector for the generation of import blocks with ` + "`terraform query`" + `.
+
+The cloud user and password are not returned by the Cloud Connector and must be added to the generated configuration.`,
+		Attributes: subaccountFilterAttributes(),
+	}
+}
+
+func (l *SubaccountListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config SubaccountListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		subaccounts, err := listSubaccounts(l.client, config)
+		if err != nil {
+			push(listResultError(errMsgFetchSubaccountsFailed, err))
+			return
+		}
+
+		for _, subaccount := range subaccounts {
+			var respObj apiobjects.SubaccountResource
+			endpoint := endpoints.GetSubaccountEndpoint(subaccount.RegionHost, subaccount.Subaccount)
+
+			if err := requestAndUnmarshal(l.client, &respObj, "GET", endpoint, nil, true); err != nil {
+				push(listResultError(errMsgFetchSubaccountFailed, err))
+				return
+			}
+
+			// The credentials are not returned by the Cloud Connector, which is handled like an import
+			model, diags := SubaccountResourceValueFrom(ctx, SubaccountConfig{}, respObj)
+			if diags.HasError() {
+				push(listResultError(errMsgMapSubaccountFailed, fmt.Errorf("%s", diags)))
+				return
+			}
+
+			displayName := respObj.Subaccount
+			if respObj.DisplayName != "" {
+				displayName = fmt.Sprintf("%s (%s)", respObj.DisplayName, respObj.Subaccount)
+			}
+
+			if !push(newListResult(ctx, req, displayName, model)) {
+				return
+			}
+		}
+	}
+}
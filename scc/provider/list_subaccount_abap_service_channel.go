@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &SubaccountABAPServiceChannelListResource{}
+
+func NewSubaccountABAPServiceChannelListResource() list.ListResource {
+	return &SubaccountABAPServiceChannelListResource{}
+}
+
+type SubaccountABAPServiceChannelListResource struct {
+	listResource
+}
+
+func (l *SubaccountABAPServiceChannelListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_abap_service_channel"
+}
+
+func (l *SubaccountABAPServiceChannelListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the ABAP service channels of the Cloud Connector subaccounts for the generation of import blocks with `terraform query`.",
+		Attributes:          subaccountFilterAttributes(),
+	}
+}
+
+func (l *SubaccountABAPServiceChannelListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config SubaccountListConfig
+	diags := req.Config.Get(ctx, &config)
+	diags.Append(checkConnectorCapabilities(ctx, l.client, "list resource", "scc_subaccount_abap_service_channel", req.Config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		subaccounts, err := listSubaccounts(l.client, config)
+		if err != nil {
+			push(listResultError(errMsgFetchSubaccountsFailed, err))
+			return
+		}
+
+		for _, subaccount := range subaccounts {
+			var respObj apiobjects.SubaccountABAPServiceChannels
+			endpoint := endpoints.GetSubaccountServiceChannelBaseEndpoint(subaccount.RegionHost, subaccount.Subaccount, "ABAPCloud")
+
+			if err := requestAndUnmarshal(l.client, &respObj.SubaccountABAPServiceChannels, "GET", endpoint, nil, true); err != nil {
+				push(listResultError(errMsgFetchSubaccountABAPServiceChannelsFailed, err))
+				return
+			}
+
+			parent := SubaccountABAPServiceChannelConfig{
+				RegionHost: types.StringValue(subaccount.RegionHost),
+				Subaccount: types.StringValue(subaccount.Subaccount),
+			}
+
+			for _, channel := range respObj.SubaccountABAPServiceChannels {
+				model, diags := SubaccountABAPServiceChannelValueFrom(ctx, parent, channel)
+				if diags.HasError() {
+					push(listResultError(errMsgMapSubaccountABAPServiceChannelFailed, fmt.Errorf("%s", diags)))
+					return
+				}
+
+				displayName := fmt.Sprintf("%s/%d (%d)", channel.ABAPCloudTenantHost, channel.InstanceNumber, channel.ID)
+				if !push(newListResult(ctx, req, displayName, model)) {
+					return
+				}
+			}
+		}
+	}
+}
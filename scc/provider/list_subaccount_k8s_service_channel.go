@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &SubaccountK8SServiceChannelListResource{}
+
+func NewSubaccountK8SServiceChannelListResource() list.ListResource {
+	return &SubaccountK8SServiceChannelListResource{}
+}
+
+type SubaccountK8SServiceChannelListResource struct {
+	listResource
+}
+
+func (l *SubaccountK8SServiceChannelListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_k8s_service_channel"
+}
+
+func (l *SubaccountK8SServiceChannelListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the K8S service channels of the Cloud Connector subaccounts for the generation of import blocks with `terraform query`.",
+		Attributes:          subaccountFilterAttributes(),
+	}
+}
+
+func (l *SubaccountK8SServiceChannelListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config SubaccountListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		subaccounts, err := listSubaccounts(l.client, config)
+		if err != nil {
+			push(listResultError(errMsgFetchSubaccountsFailed, err))
+			return
+		}
+
+		for _, subaccount := range subaccounts {
+			var respObj apiobjects.SubaccountK8SServiceChannels
+			endpoint := endpoints.GetSubaccountServiceChannelBaseEndpoint(subaccount.RegionHost, subaccount.Subaccount, "K8S")
+
+			if err := requestAndUnmarshal(l.client, &respObj.SubaccountK8SServiceChannels, "GET", endpoint, nil, true); err != nil {
+				push(listResultError(errMsgFetchSubaccountK8SServiceChannelsFailed, err))
+				return
+			}
+
+			parent := SubaccountK8SServiceChannelConfig{
+				RegionHost: types.StringValue(subaccount.RegionHost),
+				Subaccount: types.StringValue(subaccount.Subaccount),
+			}
+
+			for _, channel := range respObj.SubaccountK8SServiceChannels {
+				model, diags := SubaccountK8SServiceChannelValueFrom(ctx, parent, channel)
+				if diags.HasError() {
+					push(listResultError(errMsgMapSubaccountK8SServiceChannelFailed, fmt.Errorf("%s", diags)))
+					return
+				}
+
+				displayName := fmt.Sprintf("%s/%s (%d)", channel.K8SClusterHost, channel.K8SServiceID, channel.ID)
+				if !push(newListResult(ctx, req, displayName, model)) {
+					return
+				}
+			}
+		}
+	}
+}
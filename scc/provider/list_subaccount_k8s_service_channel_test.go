@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestListSubaccountK8SServiceChannel(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/list_subaccount_k8s_service_channel")
+		defer stopQuietly(rec)
+
+		identity := map[string]knownvalue.Check{
+			"region_host": knownvalue.StringExact("cf.eu12.hana.ondemand.com"),
+			"subaccount":  knownvalue.StringExact("9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+			"id":          knownvalue.Int64Exact(51),
+		}
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceSubaccounts("test"),
+				},
+				{
+					Query:  true,
+					Config: ListSubaccountK8SServiceChannel("test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\t    subaccount  = \"9f7390c8-f201-4b2d-b751-04c0a63c2671\""),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("scc_subaccount_k8s_service_channel.test", 1),
+						querycheck.ExpectIdentity("scc_subaccount_k8s_service_channel.test", identity),
+						querycheck.ExpectResourceDisplayName("scc_subaccount_k8s_service_channel.test", queryfilter.ByResourceIdentity(identity), knownvalue.StringExact("REDACTED_K8S_CLUSTER_HOST/REDACTED_K8S_SERVICE_ID (51)")),
+						querycheck.ExpectResourceKnownValues("scc_subaccount_k8s_service_channel.test", queryfilter.ByResourceIdentity(identity), []querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("local_port"), KnownValue: knownvalue.Int64Exact(3000)},
+						}),
+					},
+				},
+				{
+					Query:  true,
+					Config: ListSubaccountK8SServiceChannel("test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\t    subaccount  = \"7480ee65-e039-41cf-ba72-6d9a0c7d2d4e\""),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("scc_subaccount_k8s_service_channel.test", 0),
+					},
+				},
+			},
+		})
+	})
+}
+
+func ListSubaccountK8SServiceChannel(listName, filter string) string {
+	return fmt.Sprintf(`
+	list "scc_subaccount_k8s_service_channel" "%s" {
+	  provider         = scc
+	  include_resource = true
+
+	  config {
+	    %s
+	  }
+	}
+	`, listName, filter)
+}
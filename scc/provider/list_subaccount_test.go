@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestListSubaccount(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/list_subaccount")
+		defer stopQuietly(rec)
+
+		identity := map[string]knownvalue.Check{
+			"region_host": knownvalue.StringExact("cf.eu12.hana.ondemand.com"),
+			"subaccount":  knownvalue.StringExact("9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+		}
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceSubaccounts("test"),
+				},
+				{
+					Query:  true,
+					Config: ListSubaccount("test", ""),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLengthAtLeast("scc_subaccount.test", 2),
+					},
+				},
+				{
+					Query:  true,
+					Config: ListSubaccount("test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\t    subaccount  = \"9f7390c8-f201-4b2d-b751-04c0a63c2671\""),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("scc_subaccount.test", 1),
+						querycheck.ExpectIdentity("scc_subaccount.test", identity),
+						querycheck.ExpectResourceDisplayName("scc_subaccount.test", queryfilter.ByResourceIdentity(identity), knownvalue.StringExact("9f7390c8-f201-4b2d-b751-04c0a63c2671")),
+						querycheck.ExpectResourceKnownValues("scc_subaccount.test", queryfilter.ByResourceIdentity(identity), []querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("tunnel").AtMapKey("state"), KnownValue: knownvalue.StringExact("Connected")},
+						}),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount without region host", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/list_subaccount_err_region_host")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceSubaccounts("test"),
+				},
+				{
+					Query:       true,
+					Config:      ListSubaccount("test", "subaccount = \"9f7390c8-f201-4b2d-b751-04c0a63c2671\""),
+					ExpectError: regexp.MustCompile(`(?s)Attribute "region_host" must be specified when "subaccount" is\s+specified`),
+				},
+			},
+		})
+	})
+}
+
+func ListSubaccount(listName, filter string) string {
+	return fmt.Sprintf(`
+	list "scc_subaccount" "%s" {
+	  provider         = scc
+	  include_resource = true
+
+	  config {
+	    %s
+	  }
+	}
+	`, listName, filter)
+}
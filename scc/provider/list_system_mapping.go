@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &SystemMappingListResource{}
+
+func NewSystemMappingListResource() list.ListResource {
+	return &SystemMappingListResource{}
+}
+
+type SystemMappingListResource struct {
+	listResource
+}
+
+func (l *SystemMappingListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_mapping"
+}
+
+func (l *SystemMappingListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the system mappings of the Cloud Connector subaccounts for the generation of import blocks with `terraform query`.",
+		Attributes:          subaccountFilterAttributes(),
+	}
+}
+
+func (l *SystemMappingListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config SubaccountListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		subaccounts, err := listSubaccounts(l.client, config)
+		if err != nil {
+			push(listResultError(errMsgFetchSubaccountsFailed, err))
+			return
+		}
+
+		for _, subaccount := range subaccounts {
+			var respObj apiobjects.SystemMappings
+			endpoint := endpoints.GetSystemMappingBaseEndpoint(subaccount.RegionHost, subaccount.Subaccount)
+
+			if err := requestAndUnmarshal(l.client, &respObj.SystemMappings, "GET", endpoint, nil, true); err != nil {
+				push(listResultError(errMsgFetchSystemMappingsFailed, err))
+				return
+			}
+
+			parent := SystemMappingConfig{
+				RegionHost: types.StringValue(subaccount.RegionHost),
+				Subaccount: types.StringValue(subaccount.Subaccount),
+			}
+
+			for _, mapping := range respObj.SystemMappings {
+				model, err := SystemMappingValueFrom(ctx, parent, mapping)
+				if err != nil {
+					push(listResultError(errMsgMapSystemMappingFailed, err))
+					return
+				}
+
+				displayName := fmt.Sprintf("%s:%s (%s)", mapping.VirtualHost, mapping.VirtualPort, subaccount.Subaccount)
+				if !push(newListResult(ctx, req, displayName, model)) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &SystemMappingResourceListResource{}
+
+func NewSystemMappingResourceListResource() list.ListResource {
+	return &SystemMappingResourceListResource{}
+}
+
+type SystemMappingResourceListResource struct {
+	listResource
+}
+
+func (l *SystemMappingResourceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_mapping_resource"
+}
+
+func (l *SystemMappingResourceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	attributes := subaccountFilterAttributes()
+	attributes["virtual_host"] = schema.StringAttribute{
+		MarkdownDescription: "Virtual host used on the cloud side. Only the resources of this system mapping are listed.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("subaccount"), path.MatchRoot("virtual_port")),
+		},
+	}
+	attributes["virtual_port"] = schema.StringAttribute{
+		MarkdownDescription: "Port on the cloud side. Only the resources of this system mapping are listed.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("subaccount"), path.MatchRoot("virtual_host")),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the resources of the system mappings of the Cloud Connector subaccounts for the generation of import blocks with `terraform query`.",
+		Attributes:          attributes,
+	}
+}
+
+func (l *SystemMappingResourceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config SystemMappingResourceListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		subaccounts, err := listSubaccounts(l.client, SubaccountListConfig{RegionHost: config.RegionHost, Subaccount: config.Subaccount})
+		if err != nil {
+			push(listResultError(errMsgFetchSubaccountsFailed, err))
+			return
+		}
+
+		for _, subaccount := range subaccounts {
+			var mappings apiobjects.SystemMappings
+			endpoint := endpoints.GetSystemMappingBaseEndpoint(subaccount.RegionHost, subaccount.Subaccount)
+
+			if err := requestAndUnmarshal(l.client, &mappings.SystemMappings, "GET", endpoint, nil, true); err != nil {
+				push(listResultError(errMsgFetchSystemMappingsFailed, err))
+				return
+			}
+
+			for _, mapping := range mappings.SystemMappings {
+				if !config.VirtualHost.IsNull() && (mapping.VirtualHost != config.VirtualHost.ValueString() || mapping.VirtualPort != config.VirtualPort.ValueString()) {
+					continue
+				}
+
+				var respObj apiobjects.SystemMappingResources
+				endpoint := endpoints.GetSystemMappingResourceBaseEndpoint(subaccount.RegionHost, subaccount.Subaccount, mapping.VirtualHost, mapping.VirtualPort)
+
+				if err := requestAndUnmarshal(l.client, &respObj.SystemMappingResources, "GET", endpoint, nil, true); err != nil {
+					push(listResultError(errMsgFetchSystemMappingResourcesFailed, err))
+					return
+				}
+
+				parent := SystemMappingResourceConfig{
+					RegionHost:  types.StringValue(subaccount.RegionHost),
+					Subaccount:  types.StringValue(subaccount.Subaccount),
+					VirtualHost: types.StringValue(mapping.VirtualHost),
+					VirtualPort: types.StringValue(mapping.VirtualPort),
+				}
+
+				for _, mappingResource := range respObj.SystemMappingResources {
+					model, err := SystemMappingResourceValueFrom(ctx, parent, mappingResource)
+					if err != nil {
+						push(listResultError(errMsgMapSystemMappingResourceFailed, err))
+						return
+					}
+
+					displayName := fmt.Sprintf("%s:%s%s (%s)", mapping.VirtualHost, mapping.VirtualPort, mappingResource.URLPath, subaccount.Subaccount)
+					if !push(newListResult(ctx, req, displayName, model)) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestListSystemMapping(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/list_system_mapping")
+		defer stopQuietly(rec)
+
+		identity := map[string]knownvalue.Check{
+			"region_host":  knownvalue.StringExact("cf.eu12.hana.ondemand.com"),
+			"subaccount":   knownvalue.StringExact("9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+			"virtual_host": knownvalue.StringExact("testterraformvirtual"),
+			"virtual_port": knownvalue.StringExact("900"),
+		}
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + DataSourceSubaccounts("test"),
+				},
+				{
+					Query:  true,
+					Config: ListSystemMapping("test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\t    subaccount  = \"9f7390c8-f201-4b2d-b751-04c0a63c2671\""),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("scc_system_mapping.test", 1),
+						querycheck.ExpectIdentity("scc_system_mapping.test", identity),
+						querycheck.ExpectResourceDisplayName("scc_system_mapping.test", queryfilter.ByResourceIdentity(identity), knownvalue.StringExact("testterraformvirtual:900 (9f7390c8-f201-4b2d-b751-04c0a63c2671)")),
+						querycheck.ExpectResourceKnownValues("scc_system_mapping.test", queryfilter.ByResourceIdentity(identity), []querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("internal_host"), KnownValue: knownvalue.StringExact("testterraforminternal")},
+						}),
+					},
+				},
+				{
+					Query:  true,
+					Config: ListSystemMapping("test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\t    subaccount  = \"7480ee65-e039-41cf-ba72-6d9a0c7d2d4e\""),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("scc_system_mapping.test", 0),
+					},
+				},
+			},
+		})
+	})
+}
+
+func ListSystemMapping(listName, filter string) string {
+	return fmt.Sprintf(`
+	list "scc_system_mapping" "%s" {
+	  provider         = scc
+	  include_resource = true
+
+	  config {
+	    %s
+	  }
+	}
+	`, listName, filter)
+}
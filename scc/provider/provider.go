@@ -2,17 +2,25 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
 	"regexp"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -22,7 +30,11 @@ import (
 )
 
 var (
-	_ provider.Provider = &cloudConnectorProvider{}
+	_ provider.Provider                       = &cloudConnectorProvider{}
+	_ provider.ProviderWithActions            = &cloudConnectorProvider{}
+	_ provider.ProviderWithEphemeralResources = &cloudConnectorProvider{}
+	_ provider.ProviderWithFunctions          = &cloudConnectorProvider{}
+	_ provider.ProviderWithListResources      = &cloudConnectorProvider{}
 )
 
 func New() provider.Provider {
@@ -145,6 +157,8 @@ func (c *cloudConnectorProvider) Configure(ctx context.Context, req provider.Con
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ActionData = client
+	resp.ListResourceData = client
 }
 
 func resolveAttributes(config cloudConnectorProviderData) (string, string, string, string, string, string) {
@@ -235,10 +249,11 @@ func createClient(httpClient *http.Client, parsedURL *url.URL, username, passwor
 	return client
 }
 func testProviderConnection(client *api.RestApiClient) error {
-	resp, err := client.GetRequest("/api/v1/connector/version")
+	resp, err := client.GetRequest(endpoints.GetConnectorVersionEndpoint())
 	if err != nil {
 		return fmt.Errorf("connection test failed: %w", err)
 	}
+	body, rerr := io.ReadAll(resp.Body)
 	if cerr := resp.Body.Close(); cerr != nil {
 		return fmt.Errorf("failed to close response body: %w", cerr)
 	}
@@ -247,6 +262,14 @@ func testProviderConnection(client *api.RestApiClient) error {
 		return fmt.Errorf("authentication rejected with status: %s", resp.Status)
 	}
 
+	// The version is only used for version-dependent checks, an unknown version does not fail the connection test
+	var versionObj apiobjects.ConnectorVersion
+	if rerr == nil && json.Unmarshal(body, &versionObj) == nil {
+		if version, err := api.ParseConnectorVersion(versionObj.Version); err == nil {
+			client.ConnectorVersion = &version
+		}
+	}
+
 	return nil
 }
 
@@ -285,6 +308,13 @@ func (c *cloudConnectorProvider) DataSources(_ context.Context) []func() datasou
 		NewSubaccountK8SServiceChannelsDataSource,
 		NewSubaccountABAPServiceChannelDataSource,
 		NewSubaccountABAPServiceChannelsDataSource,
+		NewAlertMessagesDataSource,
+		NewConnectorVersionDataSource,
+		NewMonitoringHardwareDataSource,
+		NewMonitoringPerformanceDataSource,
+		NewMonitoringTopTimeConsumersDataSource,
+		NewSubaccountTunnelConnectionsDataSource,
+		NewConnectorConfigurationDataSource,
 	}
 }
 
@@ -298,5 +328,53 @@ func (c *cloudConnectorProvider) Resources(_ context.Context) []func() resource.
 		NewDomainMappingResource,
 		NewSubaccountK8SServiceChannelResource,
 		NewSubaccountABAPServiceChannelResource,
+		NewAlertingEmailResource,
+		NewAlertingSettingsResource,
+		NewSolutionManagementResource,
+		NewLDAPAuthenticationResource,
+		NewLocalUserResource,
+		NewSNCSettingsResource,
+		NewKerberosSettingsResource,
+		NewProxySettingsResource,
+		NewConnectorConfigurationResource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (c *cloudConnectorProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewAlertMessagesAcknowledgeAction,
+		NewSubaccountReconnectAction,
+		NewSubaccountSyncTrustAction,
+		NewServiceChannelToggleAction,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (c *cloudConnectorProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSubaccountAuthenticationDataEphemeralResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (c *cloudConnectorProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewSubaccountListResource,
+		NewSystemMappingListResource,
+		NewSystemMappingResourceListResource,
+		NewDomainMappingListResource,
+		NewSubaccountK8SServiceChannelListResource,
+		NewSubaccountABAPServiceChannelListResource,
+	}
+}
+
+// Functions defines the provider functions implemented in the provider.
+func (c *cloudConnectorProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewEncodeResourceIDFunction,
+		NewDecodeResourceIDFunction,
+		NewSystemMappingIDFunction,
+		NewParseAuthenticationDataFunction,
 	}
 }
@@ -15,7 +15,10 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -44,6 +47,16 @@ type User struct {
 	K8SCluster          string
 	K8SService          string
 	ABAPCloudTenantHost string
+	// For configuring the alerting e-mail server
+	SMTPPassword string
+	// For binding to the LDAP server
+	LDAPPassword string
+	// For rotating the local user password
+	LocalUserPassword string
+	// For uploading the keytab of the Kerberos service user
+	KerberosKeytab string
+	// For authenticating against the HTTPS proxy
+	ProxyPassword string
 }
 
 var redactedTestUser = User{
@@ -56,6 +69,11 @@ var redactedTestUser = User{
 	K8SCluster:              "REDACTED_K8S_CLUSTER_HOST",
 	K8SService:              "REDACTED_K8S_SERVICE_ID",
 	ABAPCloudTenantHost:     "REDACTED_ABAP_CLOUD_TENANT_HOST",
+	SMTPPassword:            "REDACTED_SMTP_PASSWORD",
+	LDAPPassword:            "REDACTED_LDAP_PASSWORD",
+	LocalUserPassword:       "REDACTED_LOCAL_USER_PASSWORD",
+	KerberosKeytab:          "UkVEQUNURURfS0VSQkVST1NfS0VZVEFC",
+	ProxyPassword:           "REDACTED_PROXY_PASSWORD",
 }
 
 func providerConfig(testUser User) string {
@@ -68,6 +86,18 @@ func providerConfig(testUser User) string {
 	`, testUser.InstanceURL, testUser.InstanceUsername, testUser.InstancePassword)
 }
 
+// importBlockWithIdentity imports an existing object by its resource identity instead of an import identifier.
+func importBlockWithIdentity(resourceAddress, identity string) string {
+	return fmt.Sprintf(`
+	import {
+	to = %s
+	identity = {
+	%s
+	}
+	}
+	`, resourceAddress, identity)
+}
+
 func getTestProviders(httpClient *http.Client) map[string]func() (tfprotov6.ProviderServer, error) {
 	cloudconnectorProvider := NewWithClient(httpClient).(*cloudConnectorProvider)
 
@@ -111,6 +141,11 @@ func setupVCR(t *testing.T, cassetteName string) (*recorder.Recorder, User) {
 		user.K8SCluster = os.Getenv("TF_VAR_k8s_cluster_host")
 		user.K8SService = os.Getenv("TF_VAR_k8s_service_id")
 		user.ABAPCloudTenantHost = os.Getenv("TF_VAR_abap_cloud_tenant_host")
+		user.SMTPPassword = os.Getenv("TF_VAR_smtp_password")
+		user.LDAPPassword = os.Getenv("TF_VAR_ldap_password")
+		user.LocalUserPassword = os.Getenv("TF_VAR_local_user_password")
+		user.KerberosKeytab = os.Getenv("TF_VAR_kerberos_keytab")
+		user.ProxyPassword = os.Getenv("TF_VAR_proxy_password")
 		if len(user.InstanceUsername) == 0 || len(user.InstancePassword) == 0 || len(user.InstanceURL) == 0 {
 			t.Fatal("Env vars SCC_USERNAME, SCC_PASSWORD and SCC_INSTANCE_URL are required when recording test fixtures")
 		}
@@ -126,6 +161,8 @@ func setupVCR(t *testing.T, cassetteName string) (*recorder.Recorder, User) {
 	rec.AddHook(hookRedactSensitiveCredentials(), recorder.BeforeSaveHook)
 	rec.AddHook(hookRedactBodyLinks(), recorder.BeforeSaveHook)
 	rec.AddHook(hookRedactSensitiveBody(), recorder.BeforeSaveHook)
+	rec.AddHook(hookRedactLocalUserPasswords(user), recorder.BeforeSaveHook)
+	rec.AddHook(hookRedactCloudCredentials(user), recorder.BeforeSaveHook)
 
 	return rec, user
 }
@@ -172,6 +209,48 @@ func hookRedactSensitiveCredentials() func(i *cassette.Interaction) error {
 	}
 }
 
+// hookRedactCloudCredentials redacts the cloud credentials sent for refreshing the subaccount certificate.
+// The credentials are replaced by value, so that changed credentials derived from the test user stay distinguishable.
+func hookRedactCloudCredentials(user User) func(i *cassette.Interaction) error {
+	return func(i *cassette.Interaction) error {
+		if !strings.HasSuffix(i.Request.URL, "/validity") {
+			return nil
+		}
+
+		if user.CloudPassword != "" {
+			i.Request.Body = strings.ReplaceAll(i.Request.Body, user.CloudPassword, redactedTestUser.CloudPassword)
+		}
+
+		if user.CloudUsername != "" {
+			i.Request.Body = strings.ReplaceAll(i.Request.Body, user.CloudUsername, redactedTestUser.CloudUsername)
+		}
+
+		return nil
+	}
+}
+
+// hookRedactLocalUserPasswords redacts the old and new password of the local user. They swap roles when the password is
+// rotated back and forth, so they are redacted by value and not by attribute name.
+func hookRedactLocalUserPasswords(user User) func(i *cassette.Interaction) error {
+	redactedPasswords := map[string]string{
+		user.InstancePassword:  redactedTestUser.InstancePassword,
+		user.LocalUserPassword: redactedTestUser.LocalUserPassword,
+	}
+
+	return func(i *cassette.Interaction) error {
+		rePassword := regexp.MustCompile(`"(oldPassword|newPassword)":"(.*?)"`)
+		i.Request.Body = rePassword.ReplaceAllStringFunc(i.Request.Body, func(match string) string {
+			parts := rePassword.FindStringSubmatch(match)
+			if redacted, ok := redactedPasswords[parts[2]]; ok {
+				return `"` + parts[1] + `":"` + redacted + `"`
+			}
+			return `"` + parts[1] + `":"REDACTED"`
+		})
+
+		return nil
+	}
+}
+
 func hookRedactSensitiveBody() func(i *cassette.Interaction) error {
 	return func(i *cassette.Interaction) error {
 		if strings.Contains(i.Request.Body, "cloudPassword") {
@@ -219,6 +298,26 @@ func hookRedactSensitiveBody() func(i *cassette.Interaction) error {
 			i.Response.Body = reBindingSecret.ReplaceAllString(i.Response.Body, `"abapCloudTenantHost":"`+redactedTestUser.ABAPCloudTenantHost+`"`)
 		}
 
+		if strings.Contains(i.Request.Body, "smtpPassword") {
+			reBindingSecret := regexp.MustCompile(`"smtpPassword":"(.*?)"`)
+			i.Request.Body = reBindingSecret.ReplaceAllString(i.Request.Body, `"smtpPassword":"`+redactedTestUser.SMTPPassword+`"`)
+		}
+
+		if strings.Contains(i.Request.URL, "/authentication/ldap") && strings.Contains(i.Request.Body, `"password"`) {
+			reBindingSecret := regexp.MustCompile(`"password":"(.*?)"`)
+			i.Request.Body = reBindingSecret.ReplaceAllString(i.Request.Body, `"password":"`+redactedTestUser.LDAPPassword+`"`)
+		}
+
+		if strings.Contains(i.Request.Body, "proxyPassword") {
+			reBindingSecret := regexp.MustCompile(`"proxyPassword":"(.*?)"`)
+			i.Request.Body = reBindingSecret.ReplaceAllString(i.Request.Body, `"proxyPassword":"`+redactedTestUser.ProxyPassword+`"`)
+		}
+
+		if strings.Contains(i.Request.Body, "keytab") {
+			reBindingSecret := regexp.MustCompile(`"keytab":"(.*?)"`)
+			i.Request.Body = reBindingSecret.ReplaceAllString(i.Request.Body, `"keytab":"`+redactedTestUser.KerberosKeytab+`"`)
+		}
+
 		if strings.Contains(i.Response.Body, "subaccountCertificate") {
 			reNotAfter := regexp.MustCompile(`"notAfterTimeStamp"\s*:\s*\d{13}`)
 			i.Response.Body = reNotAfter.ReplaceAllString(i.Response.Body, `"notAfterTimeStamp": 1111111111111`)
@@ -273,6 +372,15 @@ func TestSCCProvider_AllResources(t *testing.T) {
 		"scc_subaccount_k8s_service_channel",
 		"scc_subaccount_abap_service_channel",
 		"scc_subaccount_using_auth",
+		"scc_alerting_email",
+		"scc_alerting_settings",
+		"scc_solution_management",
+		"scc_ldap_authentication",
+		"scc_local_user",
+		"scc_snc_settings",
+		"scc_kerberos_settings",
+		"scc_proxy_settings",
+		"scc_connector_configuration",
 	}
 
 	ctx := context.Background()
@@ -304,6 +412,13 @@ func TestSCCProvider_AllDataSources(t *testing.T) {
 		"scc_subaccount_k8s_service_channels",
 		"scc_subaccount_abap_service_channel",
 		"scc_subaccount_abap_service_channels",
+		"scc_alert_messages",
+		"scc_connector_version",
+		"scc_monitoring_hardware",
+		"scc_monitoring_performance",
+		"scc_monitoring_top_time_consumers",
+		"scc_subaccount_tunnel_connections",
+		"scc_connector_configuration",
 	}
 
 	ctx := context.Background()
@@ -320,6 +435,97 @@ func TestSCCProvider_AllDataSources(t *testing.T) {
 	assert.ElementsMatch(t, expectedDataSources, registeredDataSources)
 }
 
+func TestSCCProvider_AllActions(t *testing.T) {
+
+	expectedActions := []string{
+		"scc_alert_messages_acknowledge",
+		"scc_subaccount_reconnect",
+		"scc_subaccount_sync_trust",
+		"scc_service_channel_toggle",
+	}
+
+	ctx := context.Background()
+	registeredActions := []string{}
+
+	for _, actionFunc := range New().(provider.ProviderWithActions).Actions(ctx) {
+		var resp action.MetadataResponse
+
+		actionFunc().Metadata(ctx, action.MetadataRequest{ProviderTypeName: "scc"}, &resp)
+
+		registeredActions = append(registeredActions, resp.TypeName)
+	}
+
+	assert.ElementsMatch(t, expectedActions, registeredActions)
+}
+
+func TestSCCProvider_AllFunctions(t *testing.T) {
+
+	expectedFunctions := []string{
+		"encode_resource_id",
+		"decode_resource_id",
+		"system_mapping_id",
+		"parse_authentication_data",
+	}
+
+	ctx := context.Background()
+	registeredFunctions := []string{}
+
+	for _, functionFunc := range New().(provider.ProviderWithFunctions).Functions(ctx) {
+		var resp function.MetadataResponse
+
+		functionFunc().Metadata(ctx, function.MetadataRequest{}, &resp)
+
+		registeredFunctions = append(registeredFunctions, resp.Name)
+	}
+
+	assert.ElementsMatch(t, expectedFunctions, registeredFunctions)
+}
+
+func TestSCCProvider_AllEphemeralResources(t *testing.T) {
+
+	expectedEphemeralResources := []string{
+		"scc_subaccount_authentication_data",
+	}
+
+	ctx := context.Background()
+	registeredEphemeralResources := []string{}
+
+	for _, ephemeralResourceFunc := range New().(provider.ProviderWithEphemeralResources).EphemeralResources(ctx) {
+		var resp ephemeral.MetadataResponse
+
+		ephemeralResourceFunc().Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "scc"}, &resp)
+
+		registeredEphemeralResources = append(registeredEphemeralResources, resp.TypeName)
+	}
+
+	assert.ElementsMatch(t, expectedEphemeralResources, registeredEphemeralResources)
+}
+
+func TestSCCProvider_AllListResources(t *testing.T) {
+
+	expectedListResources := []string{
+		"scc_subaccount",
+		"scc_system_mapping",
+		"scc_system_mapping_resource",
+		"scc_domain_mapping",
+		"scc_subaccount_k8s_service_channel",
+		"scc_subaccount_abap_service_channel",
+	}
+
+	ctx := context.Background()
+	registeredListResources := []string{}
+
+	for _, listResourceFunc := range New().(provider.ProviderWithListResources).ListResources(ctx) {
+		var resp resource.MetadataResponse
+
+		listResourceFunc().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "scc"}, &resp)
+
+		registeredListResources = append(registeredListResources, resp.TypeName)
+	}
+
+	assert.ElementsMatch(t, expectedListResources, registeredListResources)
+}
+
 func TestSCCProvider_MissingURL(t *testing.T) {
 	var resp provider.ConfigureResponse
 	ok := validateConfig("", "admin", "pass", "", "", "", &resp)
@@ -454,8 +660,8 @@ func Test_ProviderConnection_Success(t *testing.T) {
 		},
 		BaseURL:  mustParseURL(t, "https://example.com"),
 		Username: "user",
-		Password: "pass",
 	}
+	client.SetPassword("pass")
 
 	err := testProviderConnection(client)
 	assert.NoError(t, err)
@@ -477,8 +683,8 @@ func Test_ProviderConnection_Unauthorized(t *testing.T) {
 		},
 		BaseURL:  mustParseURL(t, "https://example.com"),
 		Username: "bad-user",
-		Password: "wrong-pass",
 	}
+	client.SetPassword("wrong-pass")
 
 	err := testProviderConnection(client)
 
@@ -487,6 +693,48 @@ func Test_ProviderConnection_Unauthorized(t *testing.T) {
 	assert.Contains(t, err.Error(), "unauthorized")
 }
 
+func Test_ProviderConnection_StoresConnectorVersion(t *testing.T) {
+	client := &api.RestApiClient{
+		Client: &http.Client{
+			Transport: roundTripFunc(func(req *http.Request) *http.Response {
+				return &http.Response{
+					StatusCode: 200,
+					Status:     "200 OK",
+					Body:       io.NopCloser(strings.NewReader(`{"version":"2.18.1"}`)),
+				}
+			}),
+		},
+		BaseURL:  mustParseURL(t, "https://example.com"),
+		Username: "user",
+	}
+	client.SetPassword("pass")
+
+	err := testProviderConnection(client)
+	assert.NoError(t, err)
+	assert.Equal(t, &api.ConnectorVersion{Major: 2, Minor: 18, Patch: 1}, client.ConnectorVersion)
+}
+
+func Test_ProviderConnection_UnknownConnectorVersion(t *testing.T) {
+	client := &api.RestApiClient{
+		Client: &http.Client{
+			Transport: roundTripFunc(func(req *http.Request) *http.Response {
+				return &http.Response{
+					StatusCode: 200,
+					Status:     "200 OK",
+					Body:       io.NopCloser(strings.NewReader("version info")),
+				}
+			}),
+		},
+		BaseURL:  mustParseURL(t, "https://example.com"),
+		Username: "user",
+	}
+	client.SetPassword("pass")
+
+	err := testProviderConnection(client)
+	assert.NoError(t, err)
+	assert.Nil(t, client.ConnectorVersion)
+}
+
 type roundTripFunc func(req *http.Request) *http.Response
 
 func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AlertingEmailResource{}
+
+func NewAlertingEmailResource() resource.Resource {
+	return &AlertingEmailResource{
+		singletonResource: singletonResource[apiobjects.AlertingEmail]{
+			endpoint:        endpoints.GetAlertingEmailEndpoint(),
+			description:     "alerting e-mail settings",
+			resettable:      true,
+			importAttribute: "smtp_host",
+			importValue:     func(value apiobjects.AlertingEmail) any { return value.SMTPHost },
+		},
+	}
+}
+
+type AlertingEmailResource struct {
+	singletonResource[apiobjects.AlertingEmail]
+}
+
+func (r *AlertingEmailResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alerting_email"
+}
+
+func (r *AlertingEmailResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Alerting E-Mail Configuration Resource.
+
+The Cloud Connector sends alert e-mails (for example, when a tunnel is down, a certificate is about to expire or the resource consumption is high) via the configured SMTP server. There is only one e-mail configuration per Cloud Connector instance. Deleting the resource removes the e-mail configuration.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/configure-alert-e-mails>`,
+		Attributes: map[string]schema.Attribute{
+			"smtp_host": schema.StringAttribute{
+				MarkdownDescription: "Host name of the SMTP server used to send alert e-mails.",
+				Required:            true,
+			},
+			"smtp_port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the SMTP server.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"smtp_user": schema.StringAttribute{
+				MarkdownDescription: "User for authenticating against the SMTP server. Not required if the SMTP server accepts anonymous access.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"smtp_password_wo": schema.StringAttribute{
+				MarkdownDescription: `Password of the SMTP user.
+
+**Note:**
+- This value is write-only and **will not be persisted** in the Terraform state file.
+- Change the value of ` + "`smtp_password_wo_version`" + ` to send an updated password to the Cloud Connector.`,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("smtp_user")),
+				},
+			},
+			"smtp_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the SMTP password. The password given in `smtp_password_wo` is only sent to the Cloud Connector on creation and whenever this value changes.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("smtp_password_wo")),
+				},
+			},
+			"sender": schema.StringAttribute{
+				MarkdownDescription: "E-mail address used as sender of the alert e-mails.",
+				Required:            true,
+			},
+			"recipients": schema.ListAttribute{
+				MarkdownDescription: "E-mail addresses of the recipients of the alert e-mails.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"tls_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Boolean flag indicating whether the connection to the SMTP server is secured using TLS.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"send_test_email": schema.BoolAttribute{
+				MarkdownDescription: "Boolean flag indicating whether a test e-mail is sent to the recipients after the configuration has been created or updated. This value is not stored in the Cloud Connector.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *AlertingEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config AlertingEmailConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, diags := r.buildRequestBody(ctx, plan, config.SMTPPasswordWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchAlertingEmailFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgAddAlertingEmailFailed, err.Error())
+		return
+	}
+
+	if plan.SendTestEmail.ValueBool() {
+		if err = r.sendTestEmail(); err != nil {
+			resp.Diagnostics.AddError(errMsgSendAlertingTestEmailFailed, err.Error())
+			return
+		}
+	}
+
+	responseModel, diags := AlertingEmailValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapAlertingEmailFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AlertingEmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AlertingEmailConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchAlertingEmailFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := AlertingEmailValueFrom(ctx, state, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapAlertingEmailFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AlertingEmailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config AlertingEmailConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is only sent if its version changed, otherwise the Cloud Connector keeps the current one
+	password := types.StringNull()
+	if !plan.SMTPPasswordWOVersion.Equal(state.SMTPPasswordWOVersion) {
+		password = config.SMTPPasswordWO
+	}
+
+	planBody, diags := r.buildRequestBody(ctx, plan, password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchAlertingEmailFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgUpdateAlertingEmailFailed, err.Error())
+		return
+	}
+
+	if plan.SendTestEmail.ValueBool() {
+		if err = r.sendTestEmail(); err != nil {
+			resp.Diagnostics.AddError(errMsgSendAlertingTestEmailFailed, err.Error())
+			return
+		}
+	}
+
+	responseModel, diags := AlertingEmailValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapAlertingEmailFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AlertingEmailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AlertingEmailConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reset(&resp.Diagnostics, errMsgDeleteAlertingEmailFailed)
+}
+
+func (r *AlertingEmailResource) buildRequestBody(ctx context.Context, plan AlertingEmailConfig, password types.String) (map[string]any, diag.Diagnostics) {
+	var recipients []string
+	diags := plan.Recipients.ElementsAs(ctx, &recipients, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	planBody := map[string]any{
+		"smtpHost":   plan.SMTPHost.ValueString(),
+		"smtpPort":   plan.SMTPPort.ValueInt64(),
+		"sender":     plan.Sender.ValueString(),
+		"recipients": recipients,
+	}
+
+	// Unknown values keep the current settings of the Cloud Connector
+	if !plan.SMTPUser.IsUnknown() {
+		planBody["smtpUser"] = plan.SMTPUser.ValueString()
+	}
+
+	if !plan.TLSEnabled.IsUnknown() {
+		planBody["tlsEnabled"] = plan.TLSEnabled.ValueBool()
+	}
+
+	if !password.IsNull() {
+		planBody["smtpPassword"] = password.ValueString()
+	}
+
+	return planBody, diags
+}
+
+func (r *AlertingEmailResource) sendTestEmail() error {
+	var respObj apiobjects.AlertingEmail
+	endpoint := endpoints.GetAlertingEmailEndpoint() + "/test"
+
+	return requestAndUnmarshal(r.client, &respObj, "POST", endpoint, nil, false)
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestResourceAlertingEmail(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_alerting_email")
+		if len(user.SMTPPassword) == 0 {
+			t.Fatalf("Missing TF_VAR_smtp_password for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceAlertingEmail("test", "smtp.example.com", 587, "scc-alerts", user.SMTPPassword, 1, "scc@example.com", `["basis-team@example.com"]`, true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "smtp_host", "smtp.example.com"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "smtp_port", "587"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "smtp_user", "scc-alerts"),
+						resource.TestCheckNoResourceAttr("scc_alerting_email.test", "smtp_password_wo"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "smtp_password_wo_version", "1"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "sender", "scc@example.com"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "recipients.#", "1"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "recipients.0", "basis-team@example.com"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "tls_enabled", "true"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "send_test_email", "true"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceAlertingEmail("test", "smtp.example.com", 465, "scc-alerts", user.SMTPPassword, 2, "scc-alerts@example.com", `["basis-team@example.com", "on-call@example.com"]`, false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "smtp_port", "465"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "smtp_password_wo_version", "2"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "sender", "scc-alerts@example.com"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "recipients.#", "2"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "recipients.1", "on-call@example.com"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "send_test_email", "false"),
+					),
+				},
+				{
+					// Settings that are not configured keep their current value
+					Config: providerConfig(user) + ResourceAlertingEmailWoSMTPUser("test", "smtp.example.com", 25, "scc-alerts@example.com", `["basis-team@example.com", "on-call@example.com"]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "smtp_port", "25"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "smtp_user", "scc-alerts"),
+						resource.TestCheckResourceAttr("scc_alerting_email.test", "tls_enabled", "true"),
+					),
+				},
+				{
+					ResourceName:                         "scc_alerting_email.test",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "alerting_email",
+					ImportStateVerifyIdentifierAttribute: "smtp_host",
+					ImportStateVerifyIgnore: []string{
+						"smtp_password_wo_version",
+						"send_test_email",
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - smtp host mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceAlertingEmailWoSMTPHost("test", 587, "scc@example.com", `["basis-team@example.com"]`),
+					ExpectError: regexp.MustCompile(`The argument "smtp_host" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - recipients mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceAlertingEmailWoRecipients("test", "smtp.example.com", 587, "scc@example.com"),
+					ExpectError: regexp.MustCompile(`The argument "recipients" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid smtp port", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceAlertingEmailWoSMTPUser("test", "smtp.example.com", 70000, "scc@example.com", `["basis-team@example.com"]`),
+					ExpectError: regexp.MustCompile(`(?is)Attribute smtp_port value must be between 1 and 65535`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - empty recipients", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceAlertingEmailWoSMTPUser("test", "smtp.example.com", 587, "scc@example.com", `[]`),
+					ExpectError: regexp.MustCompile(`(?is)Attribute recipients list must contain at least 1 elements`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceAlertingEmail(resourceName string, smtpHost string, smtpPort int64, smtpUser string, smtpPassword string, smtpPasswordVersion int64, sender string, recipients string, sendTestEmail bool) string {
+	return fmt.Sprintf(`
+	resource "scc_alerting_email" "%s" {
+	smtp_host = "%s"
+	smtp_port = %d
+	smtp_user = "%s"
+	smtp_password_wo = "%s"
+	smtp_password_wo_version = %d
+	sender = "%s"
+	recipients = %s
+	tls_enabled = true
+	send_test_email = %t
+	}
+	`, resourceName, smtpHost, smtpPort, smtpUser, smtpPassword, smtpPasswordVersion, sender, recipients, sendTestEmail)
+}
+
+func ResourceAlertingEmailWoSMTPUser(resourceName string, smtpHost string, smtpPort int64, sender string, recipients string) string {
+	return fmt.Sprintf(`
+	resource "scc_alerting_email" "%s" {
+	smtp_host = "%s"
+	smtp_port = %d
+	sender = "%s"
+	recipients = %s
+	}
+	`, resourceName, smtpHost, smtpPort, sender, recipients)
+}
+
+func ResourceAlertingEmailWoSMTPHost(resourceName string, smtpPort int64, sender string, recipients string) string {
+	return fmt.Sprintf(`
+	resource "scc_alerting_email" "%s" {
+	smtp_port = %d
+	sender = "%s"
+	recipients = %s
+	}
+	`, resourceName, smtpPort, sender, recipients)
+}
+
+func ResourceAlertingEmailWoRecipients(resourceName string, smtpHost string, smtpPort int64, sender string) string {
+	return fmt.Sprintf(`
+	resource "scc_alerting_email" "%s" {
+	smtp_host = "%s"
+	smtp_port = %d
+	sender = "%s"
+	}
+	`, resourceName, smtpHost, smtpPort, sender)
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AlertingSettingsResource{}
+
+func NewAlertingSettingsResource() resource.Resource {
+	return &AlertingSettingsResource{
+		singletonResource: singletonResource[apiobjects.AlertingSettings]{
+			endpoint:        endpoints.GetAlertingSettingsEndpoint(),
+			description:     "alerting settings",
+			resettable:      true,
+			importAttribute: "alert_types",
+			importValue:     func(value apiobjects.AlertingSettings) any { return value.AlertTypes },
+		},
+	}
+}
+
+type AlertingSettingsResource struct {
+	singletonResource[apiobjects.AlertingSettings]
+}
+
+func (r *AlertingSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alerting_settings"
+}
+
+func (r *AlertingSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Alerting Settings Resource.
+
+Defines which alert types are observed by the Cloud Connector. There is only one alerting settings configuration per Cloud Connector instance. Deleting the resource restores the default settings of the Cloud Connector.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/configure-alert-e-mails>`,
+		Attributes: map[string]schema.Attribute{
+			"alert_types": schema.SetAttribute{
+				MarkdownDescription: "Types of alerts that are observed by the Cloud Connector. Possible values are:" +
+					getFormattedValueAsTableRow("alert type", "description") +
+					getFormattedValueAsTableRow("---", "---") +
+					getFormattedValueAsTableRow("TUNNEL_DOWN", "The tunnel to a subaccount is disconnected or failed to connect") +
+					getFormattedValueAsTableRow("SERVICE_CHANNEL_DOWN", "A service channel is disconnected") +
+					getFormattedValueAsTableRow("CERTIFICATE_EXPIRATION", "A certificate is about to expire") +
+					getFormattedValueAsTableRow("HIGH_CPU_USAGE", "The CPU usage exceeds the configured threshold") +
+					getFormattedValueAsTableRow("HIGH_MEMORY_USAGE", "The memory usage exceeds the configured threshold") +
+					getFormattedValueAsTableRow("HIGH_DISK_USAGE", "The disk usage exceeds the configured threshold"),
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("TUNNEL_DOWN", "SERVICE_CHANNEL_DOWN", "CERTIFICATE_EXPIRATION", "HIGH_CPU_USAGE", "HIGH_MEMORY_USAGE", "HIGH_DISK_USAGE"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *AlertingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AlertingSettingsConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, diags := r.buildRequestBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchAlertingSettingsFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgAddAlertingSettingsFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := AlertingSettingsValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapAlertingSettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AlertingSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AlertingSettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchAlertingSettingsFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := AlertingSettingsValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapAlertingSettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AlertingSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AlertingSettingsConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, diags := r.buildRequestBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchAlertingSettingsFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgUpdateAlertingSettingsFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := AlertingSettingsValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapAlertingSettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AlertingSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AlertingSettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reset(&resp.Diagnostics, errMsgDeleteAlertingSettingsFailed)
+}
+
+func (r *AlertingSettingsResource) buildRequestBody(ctx context.Context, plan AlertingSettingsConfig) (map[string]any, diag.Diagnostics) {
+	var alertTypes []string
+	diags := plan.AlertTypes.ElementsAs(ctx, &alertTypes, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	planBody := map[string]any{
+		"alertTypes": alertTypes,
+	}
+
+	return planBody, diags
+}
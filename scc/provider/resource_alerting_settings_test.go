@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResourceAlertingSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_alerting_settings")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceAlertingSettings("test", `["TUNNEL_DOWN", "CERTIFICATE_EXPIRATION", "HIGH_MEMORY_USAGE"]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_alerting_settings.test", "alert_types.#", "3"),
+						resource.TestCheckTypeSetElemAttr("scc_alerting_settings.test", "alert_types.*", "TUNNEL_DOWN"),
+						resource.TestCheckTypeSetElemAttr("scc_alerting_settings.test", "alert_types.*", "CERTIFICATE_EXPIRATION"),
+						resource.TestCheckTypeSetElemAttr("scc_alerting_settings.test", "alert_types.*", "HIGH_MEMORY_USAGE"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceAlertingSettings("test", `["TUNNEL_DOWN", "SERVICE_CHANNEL_DOWN"]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_alerting_settings.test", "alert_types.#", "2"),
+						resource.TestCheckTypeSetElemAttr("scc_alerting_settings.test", "alert_types.*", "TUNNEL_DOWN"),
+						resource.TestCheckTypeSetElemAttr("scc_alerting_settings.test", "alert_types.*", "SERVICE_CHANNEL_DOWN"),
+					),
+				},
+				{
+					ResourceName:                         "scc_alerting_settings.test",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "alerting_settings",
+					ImportStateVerifyIdentifierAttribute: "alert_types.#",
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid alert type", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceAlertingSettings("test", `["TUNNEL_BROKEN"]`),
+					ExpectError: regexp.MustCompile(`(?is)value must be one of:.*TUNNEL_DOWN`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - alert types mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      `resource "scc_alerting_settings" "test" {}`,
+					ExpectError: regexp.MustCompile(`The argument "alert_types" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceAlertingSettings(resourceName string, alertTypes string) string {
+	return fmt.Sprintf(`
+	resource "scc_alerting_settings" "%s" {
+	alert_types = %s
+	}
+	`, resourceName, alertTypes)
+}
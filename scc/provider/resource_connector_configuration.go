@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = &ConnectorConfigurationResource{}
+var _ resource.ResourceWithValidateConfig = &ConnectorConfigurationResource{}
+
+func NewConnectorConfigurationResource() resource.Resource {
+	return &ConnectorConfigurationResource{
+		singletonResource: singletonResource[apiobjects.ConnectorConfiguration]{
+			endpoint:        endpoints.GetConnectorConfigurationEndpoint(),
+			description:     "connector configuration",
+			resettable:      true,
+			importAttribute: "connection_timeout",
+			importValue:     func(value apiobjects.ConnectorConfiguration) any { return value.ConnectionTimeout },
+		},
+	}
+}
+
+type ConnectorConfigurationResource struct {
+	singletonResource[apiobjects.ConnectorConfiguration]
+}
+
+func (r *ConnectorConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_configuration"
+}
+
+func (r *ConnectorConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Configuration Resource.
+
+Manages the connector-wide advanced settings, such as timeouts, the size of the worker thread pool and the maximum payload size. There is only one configuration per Cloud Connector instance. Settings that are not configured keep their current value. Deleting the resource restores the default settings.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+* If ` + "`disable_ui`" + ` is set to ` + "`true`" + `, the Cloud Connector can only be administered via the REST API.
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/configure-advanced-connectivity-settings>`,
+		Attributes: map[string]schema.Attribute{
+			"connection_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds for establishing connections to the backend systems.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"min_thread_pool_size": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of worker threads that process requests to the backend systems.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_thread_pool_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of worker threads that process requests to the backend systems.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_payload_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size in MB of a single request or response payload.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"allow_http_websocket_cloud": schema.BoolAttribute{
+				MarkdownDescription: "Allow HTTP connections on WebSocket from the cloud side.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"allow_http_websocket_on_premise": schema.BoolAttribute{
+				MarkdownDescription: "Allow HTTP connections on WebSocket to the on-premise systems.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"disable_ui": schema.BoolAttribute{
+				MarkdownDescription: "Disable the administration UI of the Cloud Connector.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ConnectorConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ConnectorConfigurationConfig
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The thread pool sizes can only be compared if both are configured and known
+	if config.MinThreadPoolSize.IsNull() || config.MinThreadPoolSize.IsUnknown() || config.MaxThreadPoolSize.IsNull() || config.MaxThreadPoolSize.IsUnknown() {
+		return
+	}
+
+	if config.MinThreadPoolSize.ValueInt64() > config.MaxThreadPoolSize.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_thread_pool_size"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The attribute min_thread_pool_size must not be greater than max_thread_pool_size, got: %d > %d", config.MinThreadPoolSize.ValueInt64(), config.MaxThreadPoolSize.ValueInt64()),
+		)
+	}
+}
+
+func (r *ConnectorConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ConnectorConfigurationConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(r.buildRequestBody(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchConnectorConfigurationFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgAddConnectorConfigurationFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := ConnectorConfigurationValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapConnectorConfigurationFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ConnectorConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ConnectorConfigurationConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchConnectorConfigurationFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := ConnectorConfigurationValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapConnectorConfigurationFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ConnectorConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ConnectorConfigurationConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(r.buildRequestBody(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchConnectorConfigurationFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgUpdateConnectorConfigurationFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := ConnectorConfigurationValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapConnectorConfigurationFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ConnectorConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ConnectorConfigurationConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reset(&resp.Diagnostics, errMsgDeleteConnectorConfigurationFailed)
+}
+
+func (r *ConnectorConfigurationResource) buildRequestBody(plan ConnectorConfigurationConfig) map[string]any {
+	planBody := map[string]any{}
+
+	// Settings that are not configured keep their current value on the Cloud Connector
+	if !plan.ConnectionTimeout.IsUnknown() {
+		planBody["connectionTimeout"] = plan.ConnectionTimeout.ValueInt64()
+	}
+	if !plan.MinThreadPoolSize.IsUnknown() {
+		planBody["minThreadPoolSize"] = plan.MinThreadPoolSize.ValueInt64()
+	}
+	if !plan.MaxThreadPoolSize.IsUnknown() {
+		planBody["maxThreadPoolSize"] = plan.MaxThreadPoolSize.ValueInt64()
+	}
+	if !plan.MaxPayloadSize.IsUnknown() {
+		planBody["maxPayloadSize"] = plan.MaxPayloadSize.ValueInt64()
+	}
+	if !plan.AllowHTTPWebSocketCloud.IsUnknown() {
+		planBody["allowHTTPWebSocketCloud"] = plan.AllowHTTPWebSocketCloud.ValueBool()
+	}
+	if !plan.AllowHTTPWebSocketOnPremise.IsUnknown() {
+		planBody["allowHTTPWebSocketOnPremise"] = plan.AllowHTTPWebSocketOnPremise.ValueBool()
+	}
+	if !plan.DisableUI.IsUnknown() {
+		planBody["uiDisabled"] = plan.DisableUI.ValueBool()
+	}
+
+	return planBody
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResourceConnectorConfiguration(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_connector_configuration")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceConnectorConfiguration("test", 60, 200),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "connection_timeout", "60"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "max_thread_pool_size", "200"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "min_thread_pool_size", "10"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "max_payload_size", "200"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "allow_http_websocket_cloud", "false"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "allow_http_websocket_on_premise", "false"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "disable_ui", "false"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceConnectorConfigurationAll("test", 120, 20, 400, 500, true, true, false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "connection_timeout", "120"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "min_thread_pool_size", "20"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "max_thread_pool_size", "400"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "max_payload_size", "500"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "allow_http_websocket_cloud", "true"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "allow_http_websocket_on_premise", "true"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "disable_ui", "false"),
+					),
+				},
+				{
+					// Settings that are not configured keep their current value
+					Config: providerConfig(user) + ResourceConnectorConfigurationWoThreadPool("test", 90),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "connection_timeout", "90"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "min_thread_pool_size", "20"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "max_thread_pool_size", "400"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "max_payload_size", "500"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "allow_http_websocket_cloud", "true"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "allow_http_websocket_on_premise", "true"),
+						resource.TestCheckResourceAttr("scc_connector_configuration.test", "disable_ui", "false"),
+					),
+				},
+				{
+					ResourceName:                         "scc_connector_configuration.test",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "connector_configuration",
+					ImportStateVerifyIdentifierAttribute: "connection_timeout",
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid connection timeout", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceConnectorConfiguration("test", 0, 200),
+					ExpectError: regexp.MustCompile(`(?is)Attribute connection_timeout value must be at least 1`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - min thread pool size greater than max thread pool size", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceConnectorConfigurationAll("test", 60, 200, 100, 100, false, false, false),
+					ExpectError: regexp.MustCompile(`(?s)The\s+attribute\s+min_thread_pool_size\s+must\s+not\s+be\s+greater\s+than\s+max_thread_pool_size,\s+got:\s+200\s+>\s+100`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceConnectorConfiguration(resourceName string, connectionTimeout int64, maxThreadPoolSize int64) string {
+	return fmt.Sprintf(`
+	resource "scc_connector_configuration" "%s" {
+	connection_timeout = %d
+	max_thread_pool_size = %d
+	}
+	`, resourceName, connectionTimeout, maxThreadPoolSize)
+}
+
+func ResourceConnectorConfigurationWoThreadPool(resourceName string, connectionTimeout int64) string {
+	return fmt.Sprintf(`
+	resource "scc_connector_configuration" "%s" {
+	connection_timeout = %d
+	}
+	`, resourceName, connectionTimeout)
+}
+
+func ResourceConnectorConfigurationAll(resourceName string, connectionTimeout int64, minThreadPoolSize int64, maxThreadPoolSize int64, maxPayloadSize int64, allowHTTPWebSocketCloud bool, allowHTTPWebSocketOnPremise bool, disableUI bool) string {
+	return fmt.Sprintf(`
+	resource "scc_connector_configuration" "%s" {
+	connection_timeout = %d
+	min_thread_pool_size = %d
+	max_thread_pool_size = %d
+	max_payload_size = %d
+	allow_http_websocket_cloud = %t
+	allow_http_websocket_on_premise = %t
+	disable_ui = %t
+	}
+	`, resourceName, connectionTimeout, minThreadPoolSize, maxThreadPoolSize, maxPayloadSize, allowHTTPWebSocketCloud, allowHTTPWebSocketOnPremise, disableUI)
+}
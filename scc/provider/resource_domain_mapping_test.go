@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestResourceDomainMapping(t *testing.T) {
@@ -64,6 +67,34 @@ func TestResourceDomainMapping(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - import by identity", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_domain_mapping_import_identity")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_12_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + importBlockWithIdentity("scc_domain_mapping.test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\tsubaccount = \"9f7390c8-f201-4b2d-b751-04c0a63c2671\"\n\tinternal_domain = \"testterraforminternaldomain\"") + ResourceDomainMapping("test", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "testterraformvirtualdomain", "testterraforminternaldomain"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_domain_mapping.test", "virtual_domain", "testterraformvirtualdomain"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("scc_domain_mapping.test", map[string]knownvalue.Check{
+							"region_host":     knownvalue.StringExact("cf.eu12.hana.ondemand.com"),
+							"subaccount":      knownvalue.StringExact("9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+							"internal_domain": knownvalue.StringExact("testterraforminternaldomain"),
+						}),
+					},
+				},
+			},
+		})
+	})
+
 	t.Run("error path - region host mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &KerberosSettingsResource{}
+
+func NewKerberosSettingsResource() resource.Resource {
+	return &KerberosSettingsResource{
+		singletonResource: singletonResource[apiobjects.KerberosSettings]{
+			endpoint:        endpoints.GetKerberosSettingsEndpoint(),
+			description:     "Kerberos settings",
+			resettable:      true,
+			importAttribute: "service_user",
+			importValue:     func(value apiobjects.KerberosSettings) any { return value.ServiceUser },
+		},
+	}
+}
+
+type KerberosSettingsResource struct {
+	singletonResource[apiobjects.KerberosSettings]
+}
+
+func (r *KerberosSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kerberos_settings"
+}
+
+func (r *KerberosSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Kerberos Settings Resource.
+
+System mappings with the authentication mode ` + "`KERBEROS`" + ` use Kerberos constrained delegation to propagate the identity of the cloud user to the backend. For this, the Cloud Connector needs to know the Kerberos realms with their Key Distribution Centers (KDCs) as well as the service user it acts as and its keytab. There is only one Kerberos configuration per Cloud Connector instance. Deleting the resource removes the Kerberos configuration.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/configure-kerberos>`,
+		Attributes: map[string]schema.Attribute{
+			"realms": schema.ListNestedAttribute{
+				MarkdownDescription: "Kerberos realms the Cloud Connector requests tickets from.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the Kerberos realm, for example `EXAMPLE.COM`.",
+							Required:            true,
+						},
+						"kdc_hosts": schema.ListAttribute{
+							MarkdownDescription: "Key Distribution Centers of the realm in the format `host:port`. The default port 88 is used if the port is omitted.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"service_user": schema.StringAttribute{
+				MarkdownDescription: "Kerberos principal of the service user the Cloud Connector uses to request tickets on behalf of the cloud users, for example `scc-service@EXAMPLE.COM`.",
+				Required:            true,
+			},
+			"keytab_wo": schema.StringAttribute{
+				MarkdownDescription: `Base64-encoded keytab of the service user, for example ` + "`filebase64(\"scc-service.keytab\")`" + `.
+
+**Note:**
+- This value is write-only and **will not be persisted** in the Terraform state file.
+- Change the value of ` + "`keytab_wo_version`" + ` to upload an updated keytab to the Cloud Connector.`,
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9+/]+={0,2}$`), "must be base64-encoded"),
+				},
+			},
+			"keytab_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the keytab. The keytab given in `keytab_wo` is only uploaded to the Cloud Connector on creation and whenever this value changes.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *KerberosSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config KerberosSettingsConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, diags := r.buildRequestBody(ctx, plan, config.KeytabWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchKerberosSettingsFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgAddKerberosSettingsFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := KerberosSettingsValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapKerberosSettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *KerberosSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state KerberosSettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchKerberosSettingsFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := KerberosSettingsValueFrom(ctx, state, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapKerberosSettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *KerberosSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config KerberosSettingsConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The keytab is only uploaded if its version changed, otherwise the Cloud Connector keeps the current one
+	keytab := types.StringNull()
+	if !plan.KeytabWOVersion.Equal(state.KeytabWOVersion) {
+		keytab = config.KeytabWO
+	}
+
+	planBody, diags := r.buildRequestBody(ctx, plan, keytab)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchKerberosSettingsFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgUpdateKerberosSettingsFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := KerberosSettingsValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapKerberosSettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *KerberosSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state KerberosSettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reset(&resp.Diagnostics, errMsgDeleteKerberosSettingsFailed)
+}
+
+func (r *KerberosSettingsResource) buildRequestBody(ctx context.Context, plan KerberosSettingsConfig, keytab types.String) (map[string]any, diag.Diagnostics) {
+	var realmsData []KerberosRealmData
+	diags := plan.Realms.ElementsAs(ctx, &realmsData, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	realms := []apiobjects.KerberosRealm{}
+	for _, realm := range realmsData {
+		var kdcs []string
+		diags.Append(realm.KDCHosts.ElementsAs(ctx, &kdcs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		realms = append(realms, apiobjects.KerberosRealm{
+			Name: realm.Name.ValueString(),
+			KDCs: kdcs,
+		})
+	}
+
+	planBody := map[string]any{
+		"realms":      realms,
+		"serviceUser": plan.ServiceUser.ValueString(),
+	}
+
+	if !keytab.IsNull() {
+		planBody["keytab"] = keytab.ValueString()
+	}
+
+	return planBody, diags
+}
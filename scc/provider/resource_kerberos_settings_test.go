@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestResourceKerberosSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_kerberos_settings")
+		if len(user.KerberosKeytab) == 0 {
+			t.Fatalf("Missing TF_VAR_kerberos_keytab for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceKerberosSettings("test", `[{ name = "EXAMPLE.COM", kdc_hosts = ["kdc1.example.com:88"] }]`, "scc-service@EXAMPLE.COM", user.KerberosKeytab, 1),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "realms.#", "1"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "realms.0.name", "EXAMPLE.COM"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "realms.0.kdc_hosts.#", "1"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "realms.0.kdc_hosts.0", "kdc1.example.com:88"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "service_user", "scc-service@EXAMPLE.COM"),
+						resource.TestCheckNoResourceAttr("scc_kerberos_settings.test", "keytab_wo"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "keytab_wo_version", "1"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceKerberosSettings("test", `[{ name = "EXAMPLE.COM", kdc_hosts = ["kdc1.example.com:88", "kdc2.example.com"] }, { name = "SUB.EXAMPLE.COM", kdc_hosts = ["kdc.sub.example.com:88"] }]`, "scc-service@EXAMPLE.COM", user.KerberosKeytab, 2),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "realms.#", "2"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "realms.0.kdc_hosts.#", "2"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "realms.0.kdc_hosts.1", "kdc2.example.com"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "realms.1.name", "SUB.EXAMPLE.COM"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.test", "keytab_wo_version", "2"),
+					),
+				},
+				{
+					ResourceName:                         "scc_kerberos_settings.test",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "kerberos_settings",
+					ImportStateVerifyIdentifierAttribute: "service_user",
+					ImportStateVerifyIgnore: []string{
+						"keytab_wo_version",
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - realms mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceKerberosSettingsWoRealms("test", "scc-service@EXAMPLE.COM", "a2V5dGFi"),
+					ExpectError: regexp.MustCompile(`The argument "realms" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - empty kdc hosts", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceKerberosSettings("test", `[{ name = "EXAMPLE.COM", kdc_hosts = [] }]`, "scc-service@EXAMPLE.COM", "a2V5dGFi", 1),
+					ExpectError: regexp.MustCompile(`(?is)Attribute realms\[0\].kdc_hosts list must contain at least 1 elements`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - keytab not base64-encoded", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceKerberosSettings("test", `[{ name = "EXAMPLE.COM", kdc_hosts = ["kdc1.example.com:88"] }]`, "scc-service@EXAMPLE.COM", "not a keytab!", 1),
+					ExpectError: regexp.MustCompile(`(?is)Attribute keytab_wo must be base64-encoded`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceKerberosSettings(resourceName string, realms string, serviceUser string, keytab string, keytabVersion int64) string {
+	return fmt.Sprintf(`
+	resource "scc_kerberos_settings" "%s" {
+	realms = %s
+	service_user = "%s"
+	keytab_wo = "%s"
+	keytab_wo_version = %d
+	}
+	`, resourceName, realms, serviceUser, keytab, keytabVersion)
+}
+
+func ResourceKerberosSettingsWoRealms(resourceName string, serviceUser string, keytab string) string {
+	return fmt.Sprintf(`
+	resource "scc_kerberos_settings" "%s" {
+	service_user = "%s"
+	keytab_wo = "%s"
+	}
+	`, resourceName, serviceUser, keytab)
+}
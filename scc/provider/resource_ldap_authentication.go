@@ -0,0 +1,415 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ resource.Resource = &LDAPAuthenticationResource{}
+
+func NewLDAPAuthenticationResource() resource.Resource {
+	return &LDAPAuthenticationResource{
+		singletonResource: singletonResource[apiobjects.LDAPAuthentication]{
+			endpoint:        endpoints.GetLDAPAuthenticationEndpoint(),
+			description:     "LDAP authentication settings",
+			resettable:      true,
+			importAttribute: "user_base_dn",
+			importValue:     func(value apiobjects.LDAPAuthentication) any { return value.UserBaseDN },
+		},
+	}
+}
+
+type LDAPAuthenticationResource struct {
+	singletonResource[apiobjects.LDAPAuthentication]
+}
+
+func (r *LDAPAuthenticationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_authentication"
+}
+
+func ldapHostsSchema(description string, required bool) schema.ListNestedAttribute {
+	var validators []validator.List
+	if required {
+		validators = append(validators, listvalidator.SizeAtLeast(1))
+	}
+
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Required:            required,
+		Optional:            !required,
+		Computed:            !required,
+		Validators:          validators,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"host": schema.StringAttribute{
+					MarkdownDescription: "Host name of the LDAP server.",
+					Required:            true,
+				},
+				"port": schema.Int64Attribute{
+					MarkdownDescription: "Port of the LDAP server.",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+				"tls_enabled": schema.BoolAttribute{
+					MarkdownDescription: "Boolean flag indicating whether the connection to the LDAP server is secured using TLS (LDAPS).",
+					Optional:            true,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (r *LDAPAuthenticationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector LDAP Authentication Resource.
+
+By default, users sign in to the Cloud Connector administration UI with the local user. This resource switches the authentication of the administration UI to an LDAP server. The LDAP groups given in the role mapping determine which Cloud Connector role a user is assigned to. There is only one LDAP configuration per Cloud Connector instance. Deleting the resource switches the authentication back to the local user.
+
+**Note:** Once LDAP authentication is active, the credentials used in the provider configuration must belong to an LDAP user that is assigned to the Administrator role.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/use-ldap-for-authentication>`,
+		Attributes: map[string]schema.Attribute{
+			"hosts":           ldapHostsSchema("LDAP servers used for authentication. The servers are tried in the given order.", true),
+			"secondary_hosts": ldapHostsSchema("LDAP servers used for authentication if none of the servers given in `hosts` is reachable.", false),
+			"user_base_dn": schema.StringAttribute{
+				MarkdownDescription: "Distinguished name of the LDAP entry below which users are searched.",
+				Required:            true,
+			},
+			"group_base_dn": schema.StringAttribute{
+				MarkdownDescription: "Distinguished name of the LDAP entry below which groups are searched.",
+				Required:            true,
+			},
+			"connection_user": schema.StringAttribute{
+				MarkdownDescription: "Distinguished name of the user the Cloud Connector binds to the LDAP server with. Not required if the LDAP server accepts anonymous binds.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"connection_password_wo": schema.StringAttribute{
+				MarkdownDescription: `Password of the connection user.
+
+**Note:**
+- This value is write-only and **will not be persisted** in the Terraform state file.
+- Change the value of ` + "`connection_password_wo_version`" + ` to send an updated password to the Cloud Connector.`,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("connection_user")),
+				},
+			},
+			"connection_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the connection password. The password given in `connection_password_wo` is only sent to the Cloud Connector on creation and whenever this value changes.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("connection_password_wo")),
+				},
+			},
+			"role_mapping": schema.SingleNestedAttribute{
+				MarkdownDescription: "Names of the LDAP groups that are mapped to the Cloud Connector roles.",
+				Optional:            true,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"administrator": schema.StringAttribute{
+						MarkdownDescription: "LDAP group mapped to the Administrator role.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"display": schema.StringAttribute{
+						MarkdownDescription: "LDAP group mapped to the Display role.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"support": schema.StringAttribute{
+						MarkdownDescription: "LDAP group mapped to the Support role.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"subaccount_administrator": schema.StringAttribute{
+						MarkdownDescription: "LDAP group mapped to the Subaccount Administrator role.",
+						Optional:            true,
+						Computed:            true,
+					},
+				},
+			},
+			"test_connection": schema.BoolAttribute{
+				MarkdownDescription: "Boolean flag indicating whether the connection to the LDAP servers is tested before the configuration is created or updated. If the test fails, the configuration is not changed. This value is not stored in the Cloud Connector.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *LDAPAuthenticationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config LDAPAuthenticationConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, diags := r.buildRequestBody(ctx, plan, config.ConnectionPasswordWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchLDAPAuthenticationFailed, err.Error())
+		return
+	}
+
+	// The connection is tested before the configuration is applied to avoid locking out all users
+	if plan.TestConnection.ValueBool() {
+		if err = r.testConnection(planBody); err != nil {
+			resp.Diagnostics.AddError(errMsgTestLDAPAuthenticationFailed, err.Error())
+			return
+		}
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgAddLDAPAuthenticationFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := LDAPAuthenticationValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapLDAPAuthenticationFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LDAPAuthenticationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LDAPAuthenticationConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchLDAPAuthenticationFailed, err.Error())
+		return
+	}
+
+	// The authentication was switched back to the local user outside of Terraform
+	if !respObj.Enabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	responseModel, diags := LDAPAuthenticationValueFrom(ctx, state, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapLDAPAuthenticationFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LDAPAuthenticationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config LDAPAuthenticationConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is only sent if its version changed, otherwise the Cloud Connector keeps the current one
+	password := types.StringNull()
+	if !plan.ConnectionPasswordWOVersion.Equal(state.ConnectionPasswordWOVersion) {
+		password = config.ConnectionPasswordWO
+	}
+
+	planBody, diags := r.buildRequestBody(ctx, plan, password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchLDAPAuthenticationFailed, err.Error())
+		return
+	}
+
+	// The Cloud Connector does not return the current password, so the test always uses the configured one
+	if plan.TestConnection.ValueBool() {
+		testBody := maps.Clone(planBody)
+		if !config.ConnectionPasswordWO.IsNull() {
+			testBody["password"] = config.ConnectionPasswordWO.ValueString()
+		}
+
+		if err := r.testConnection(testBody); err != nil {
+			resp.Diagnostics.AddError(errMsgTestLDAPAuthenticationFailed, err.Error())
+			return
+		}
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgUpdateLDAPAuthenticationFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := LDAPAuthenticationValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapLDAPAuthenticationFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LDAPAuthenticationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LDAPAuthenticationConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reset(&resp.Diagnostics, errMsgDeleteLDAPAuthenticationFailed)
+}
+
+func (r *LDAPAuthenticationResource) buildHostsRequestBody(ctx context.Context, value types.List) ([]map[string]any, diag.Diagnostics) {
+	hostsBody := []map[string]any{}
+	if value.IsNull() || value.IsUnknown() {
+		return hostsBody, nil
+	}
+
+	var hosts []LDAPHostData
+	diags := value.ElementsAs(ctx, &hosts, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, host := range hosts {
+		hostsBody = append(hostsBody, map[string]any{
+			"host":   host.Host.ValueString(),
+			"port":   host.Port.ValueInt64(),
+			"secure": host.TLSEnabled.ValueBool(),
+		})
+	}
+
+	return hostsBody, diags
+}
+
+func (r *LDAPAuthenticationResource) buildRequestBody(ctx context.Context, plan LDAPAuthenticationConfig, password types.String) (map[string]any, diag.Diagnostics) {
+	hosts, diags := r.buildHostsRequestBody(ctx, plan.Hosts)
+
+	secondaryHosts, secondaryDiags := r.buildHostsRequestBody(ctx, plan.SecondaryHosts)
+	diags.Append(secondaryDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	planBody := map[string]any{
+		"enabled":   true,
+		"hosts":     hosts,
+		"userBase":  plan.UserBaseDN.ValueString(),
+		"groupBase": plan.GroupBaseDN.ValueString(),
+	}
+
+	// Unknown values keep the current settings of the Cloud Connector
+	if !plan.SecondaryHosts.IsUnknown() {
+		planBody["secondaryHosts"] = secondaryHosts
+	}
+
+	if !plan.ConnectionUser.IsUnknown() {
+		planBody["user"] = plan.ConnectionUser.ValueString()
+	}
+
+	if !password.IsNull() {
+		planBody["password"] = password.ValueString()
+	}
+
+	// Role mappings that are not configured are left to the Cloud Connector defaults
+	if !plan.RoleMapping.IsNull() && !plan.RoleMapping.IsUnknown() {
+		var roleMapping LDAPRoleMappingData
+		diags.Append(plan.RoleMapping.As(ctx, &roleMapping, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		roles := map[string]string{}
+		for key, value := range map[string]types.String{
+			"admin":           roleMapping.Administrator,
+			"display":         roleMapping.Display,
+			"support":         roleMapping.Support,
+			"subaccountAdmin": roleMapping.SubaccountAdministrator,
+		} {
+			if !value.IsNull() && !value.IsUnknown() {
+				roles[key] = value.ValueString()
+			}
+		}
+
+		planBody["roles"] = roles
+	}
+
+	return planBody, diags
+}
+
+func (r *LDAPAuthenticationResource) testConnection(planBody map[string]any) error {
+	var respObj apiobjects.LDAPAuthentication
+	endpoint := endpoints.GetLDAPAuthenticationTestEndpoint()
+
+	return requestAndUnmarshal(r.client, &respObj, "POST", endpoint, planBody, false)
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestResourceLDAPAuthentication(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_ldap_authentication")
+		if len(user.LDAPPassword) == 0 {
+			t.Fatalf("Missing TF_VAR_ldap_password for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceLDAPAuthentication("test", `[{ host = "ldap1.example.com", port = 636, tls_enabled = true }]`, "ou=users,dc=example,dc=com", "ou=groups,dc=example,dc=com", user.LDAPPassword, 1, `{ administrator = "scc-admins" }`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "hosts.#", "1"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "hosts.0.host", "ldap1.example.com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "hosts.0.port", "636"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "hosts.0.tls_enabled", "true"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "secondary_hosts.#", "1"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "secondary_hosts.0.host", "ldap-dr.example.com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "user_base_dn", "ou=users,dc=example,dc=com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "group_base_dn", "ou=groups,dc=example,dc=com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "connection_user", "cn=scc-bind,ou=services,dc=example,dc=com"),
+						resource.TestCheckNoResourceAttr("scc_ldap_authentication.test", "connection_password_wo"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "connection_password_wo_version", "1"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "role_mapping.administrator", "scc-admins"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "role_mapping.display", "sccdisplay"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "role_mapping.support", "sccsupport"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "role_mapping.subaccount_administrator", "sccsubadmin"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "test_connection", "true"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceLDAPAuthentication("test", `[{ host = "ldap1.example.com", port = 636, tls_enabled = true }, { host = "ldap2.example.com", port = 389 }]`, "ou=users,dc=example,dc=com", "ou=scc,ou=groups,dc=example,dc=com", user.LDAPPassword, 2, `{ administrator = "scc-admins", display = "scc-viewers" }`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "hosts.#", "2"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "hosts.1.host", "ldap2.example.com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "hosts.1.port", "389"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "hosts.1.tls_enabled", "false"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "group_base_dn", "ou=scc,ou=groups,dc=example,dc=com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "connection_password_wo_version", "2"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "role_mapping.display", "scc-viewers"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "role_mapping.support", "sccsupport"),
+					),
+				},
+				{
+					// Settings that are not configured keep their current value
+					Config: providerConfig(user) + ResourceLDAPAuthenticationWoRoleMapping("test", `[{ host = "ldap1.example.com", port = 636, tls_enabled = true }]`, "ou=users,dc=example,dc=com"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "hosts.#", "1"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "group_base_dn", "ou=groups,dc=example,dc=com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "connection_user", "cn=scc-bind,ou=services,dc=example,dc=com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "secondary_hosts.#", "1"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "role_mapping.administrator", "scc-admins"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "role_mapping.display", "scc-viewers"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "role_mapping.support", "sccsupport"),
+					),
+				},
+				{
+					ResourceName:                         "scc_ldap_authentication.test",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "ldap_authentication",
+					ImportStateVerifyIdentifierAttribute: "user_base_dn",
+					ImportStateVerifyIgnore: []string{
+						"connection_password_wo_version",
+						"test_connection",
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - update with unchanged password version", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_ldap_authentication_update_password_unchanged")
+		if len(user.LDAPPassword) == 0 {
+			t.Fatalf("Missing TF_VAR_ldap_password for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceLDAPAuthentication("test", `[{ host = "ldap1.example.com", port = 636, tls_enabled = true }]`, "ou=users,dc=example,dc=com", "ou=groups,dc=example,dc=com", user.LDAPPassword, 1, `{ administrator = "scc-admins" }`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "group_base_dn", "ou=groups,dc=example,dc=com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "connection_password_wo_version", "1"),
+					),
+				},
+				{
+					// The connection test uses the configured password, the update keeps the current one
+					Config: providerConfig(user) + ResourceLDAPAuthentication("test", `[{ host = "ldap1.example.com", port = 636, tls_enabled = true }]`, "ou=users,dc=example,dc=com", "ou=scc,ou=groups,dc=example,dc=com", user.LDAPPassword, 1, `{ administrator = "scc-admins" }`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "group_base_dn", "ou=scc,ou=groups,dc=example,dc=com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "connection_password_wo_version", "1"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.test", "test_connection", "true"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - connection test failed", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_ldap_authentication_err_test_connection")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      providerConfig(user) + ResourceLDAPAuthentication("test", `[{ host = "unreachable.example.com", port = 636, tls_enabled = true }]`, "ou=users,dc=example,dc=com", "ou=groups,dc=example,dc=com", user.LDAPPassword, 1, `{ administrator = "scc-admins" }`),
+					ExpectError: regexp.MustCompile(`(?is)error testing the connection to the LDAP server.*is not\s+reachable`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - hosts mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceLDAPAuthenticationWoConnectionUser("test", `[]`, "ou=users,dc=example,dc=com"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute hosts list must contain at least 1 elements`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid port", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceLDAPAuthenticationWoConnectionUser("test", `[{ host = "ldap1.example.com", port = 0 }]`, "ou=users,dc=example,dc=com"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute hosts\[0\].port value must be between 1 and 65535`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - user base dn mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceLDAPAuthenticationWoUserBaseDN("test", `[{ host = "ldap1.example.com", port = 636 }]`),
+					ExpectError: regexp.MustCompile(`The argument "user_base_dn" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceLDAPAuthentication(resourceName string, hosts string, userBaseDN string, groupBaseDN string, connectionPassword string, connectionPasswordVersion int64, roleMapping string) string {
+	return fmt.Sprintf(`
+	resource "scc_ldap_authentication" "%s" {
+	hosts = %s
+	secondary_hosts = [{ host = "ldap-dr.example.com", port = 636, tls_enabled = true }]
+	user_base_dn = "%s"
+	group_base_dn = "%s"
+	connection_user = "cn=scc-bind,ou=services,dc=example,dc=com"
+	connection_password_wo = "%s"
+	connection_password_wo_version = %d
+	role_mapping = %s
+	test_connection = true
+	}
+	`, resourceName, hosts, userBaseDN, groupBaseDN, connectionPassword, connectionPasswordVersion, roleMapping)
+}
+
+func ResourceLDAPAuthenticationWoConnectionUser(resourceName string, hosts string, userBaseDN string) string {
+	return fmt.Sprintf(`
+	resource "scc_ldap_authentication" "%s" {
+	hosts = %s
+	user_base_dn = "%s"
+	group_base_dn = "ou=groups,dc=example,dc=com"
+	}
+	`, resourceName, hosts, userBaseDN)
+}
+
+func ResourceLDAPAuthenticationWoRoleMapping(resourceName string, hosts string, userBaseDN string) string {
+	return fmt.Sprintf(`
+	resource "scc_ldap_authentication" "%s" {
+	hosts = %s
+	secondary_hosts = [{ host = "ldap-dr.example.com", port = 636, tls_enabled = true }]
+	user_base_dn = "%s"
+	group_base_dn = "ou=groups,dc=example,dc=com"
+	}
+	`, resourceName, hosts, userBaseDN)
+}
+
+func ResourceLDAPAuthenticationWoUserBaseDN(resourceName string, hosts string) string {
+	return fmt.Sprintf(`
+	resource "scc_ldap_authentication" "%s" {
+	hosts = %s
+	group_base_dn = "ou=groups,dc=example,dc=com"
+	}
+	`, resourceName, hosts)
+}
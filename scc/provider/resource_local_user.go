@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = &LocalUserResource{}
+
+func NewLocalUserResource() resource.Resource {
+	return &LocalUserResource{
+		singletonResource: singletonResource[apiobjects.LocalUser]{
+			endpoint:        endpoints.GetLocalUserEndpoint(),
+			description:     "local administrator credentials",
+			resettable:      false,
+			importAttribute: "user_name",
+			importValue:     func(value apiobjects.LocalUser) any { return value.User },
+		},
+	}
+}
+
+type LocalUserResource struct {
+	singletonResource[apiobjects.LocalUser]
+}
+
+func (r *LocalUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_local_user"
+}
+
+func (r *LocalUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Local User Resource.
+
+If LDAP is not used for authentication, the local administrator user is the only user that can sign in to the Cloud Connector. This resource rotates the password of the local administrator user. There is only one local user per Cloud Connector instance. Deleting the resource only removes it from the Terraform state with a warning, the password remains unchanged.
+
+**Note:**
+- The password is changed using the credentials of the provider configuration, so the provider must be configured with basic authentication for the local administrator user.
+- After the password has been changed, the provider uses the new password for all subsequent requests of the same run. Update the password in the provider configuration before the next run.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/initial-configuration>`,
+		Attributes: map[string]schema.Attribute{
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the local administrator user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: `New password of the local administrator user.
+
+**Note:**
+- This value is write-only and **will not be persisted** in the Terraform state file.
+- Change the value of ` + "`password_wo_version`" + ` to rotate the password.`,
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the password. The password given in `password_wo` is sent to the Cloud Connector on creation, if it differs from the password of the provider configuration, and whenever this value changes.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *LocalUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config LocalUserConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopting the local user with its current password does not require a password change
+	if config.PasswordWO.ValueString() != r.client.CurrentPassword() {
+		if err := r.changePassword(config.PasswordWO.ValueString()); err != nil {
+			resp.Diagnostics.AddError(errMsgUpdateLocalUserPasswordFailed, err.Error())
+			return
+		}
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchLocalUserFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := LocalUserValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapLocalUserFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LocalUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LocalUserConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchLocalUserFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := LocalUserValueFrom(ctx, state, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapLocalUserFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LocalUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config LocalUserConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is only rotated if its version changed
+	if !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		if err := r.changePassword(config.PasswordWO.ValueString()); err != nil {
+			resp.Diagnostics.AddError(errMsgUpdateLocalUserPasswordFailed, err.Error())
+			return
+		}
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchLocalUserFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := LocalUserValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapLocalUserFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LocalUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The local administrator user cannot be deleted, so the resource is only removed from the state
+	r.reset(&resp.Diagnostics, errMsgDeleteLocalUserFailed)
+}
+
+// changePassword rotates the password of the local user and switches the client to the new password,
+// so that the remaining requests of the same run are not rejected.
+func (r *LocalUserResource) changePassword(newPassword string) error {
+	var respObj apiobjects.LocalUser
+
+	currentPassword := r.client.CurrentPassword()
+	if r.client.Username == "" || currentPassword == "" {
+		return fmt.Errorf("changing the local user password requires basic authentication in the provider configuration")
+	}
+
+	planBody := map[string]string{
+		"oldPassword": currentPassword,
+		"newPassword": newPassword,
+	}
+
+	err := requestAndUnmarshal(r.client, &respObj, "PUT", endpoints.GetLocalUserEndpoint(), planBody, false)
+	if err != nil {
+		return err
+	}
+
+	r.client.SetPassword(newPassword)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestResourceLocalUser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_local_user")
+		if len(user.LocalUserPassword) == 0 {
+			t.Fatalf("Missing TF_VAR_local_user_password for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		// After the rotation the provider has to be configured with the new password
+		rotatedUser := user
+		rotatedUser.InstancePassword = user.LocalUserPassword
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			// A refresh with the provider configuration of the same step would use the outdated password
+			AdditionalCLIOptions: &resource.AdditionalCLIOptions{
+				Plan: resource.PlanOptions{NoRefresh: true},
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceLocalUser("test", user.InstancePassword, 1),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_local_user.test", "user_name", "Administrator"),
+						resource.TestCheckNoResourceAttr("scc_local_user.test", "password_wo"),
+						resource.TestCheckResourceAttr("scc_local_user.test", "password_wo_version", "1"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceLocalUser("test", user.LocalUserPassword, 2),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_local_user.test", "user_name", "Administrator"),
+						resource.TestCheckResourceAttr("scc_local_user.test", "password_wo_version", "2"),
+					),
+				},
+				{
+					Config: providerConfig(rotatedUser) + ResourceLocalUser("test", user.InstancePassword, 3),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_local_user.test", "password_wo_version", "3"),
+					),
+				},
+				{
+					Config:                               providerConfig(user) + ResourceLocalUser("test", user.InstancePassword, 3),
+					ResourceName:                         "scc_local_user.test",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "local_user",
+					ImportStateVerifyIdentifierAttribute: "user_name",
+					ImportStateVerifyIgnore: []string{
+						"password_wo_version",
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - password version mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceLocalUserWoPasswordVersion("test", "new-password"),
+					ExpectError: regexp.MustCompile(`The argument "password_wo_version" is required, but no definition was\s+found.`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceLocalUser(resourceName string, password string, passwordVersion int64) string {
+	return fmt.Sprintf(`
+	resource "scc_local_user" "%s" {
+	password_wo = "%s"
+	password_wo_version = %d
+	}
+	`, resourceName, password, passwordVersion)
+}
+
+func ResourceLocalUserWoPasswordVersion(resourceName string, password string) string {
+	return fmt.Sprintf(`
+	resource "scc_local_user" "%s" {
+	password_wo = "%s"
+	}
+	`, resourceName, password)
+}
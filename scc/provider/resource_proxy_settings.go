@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ProxySettingsResource{}
+
+func NewProxySettingsResource() resource.Resource {
+	return &ProxySettingsResource{
+		singletonResource: singletonResource[apiobjects.ProxySettings]{
+			endpoint:        endpoints.GetProxySettingsEndpoint(),
+			description:     "proxy settings",
+			resettable:      true,
+			importAttribute: "host",
+			importValue:     func(value apiobjects.ProxySettings) any { return value.Host },
+		},
+	}
+}
+
+type ProxySettingsResource struct {
+	singletonResource[apiobjects.ProxySettings]
+}
+
+func (r *ProxySettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy_settings"
+}
+
+func (r *ProxySettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Proxy Settings Resource.
+
+If the Cloud Connector host cannot reach the SAP BTP region directly, the tunnel connections are established via an HTTPS proxy. There is only one proxy configuration per Cloud Connector instance. Deleting the resource removes the proxy configuration, so that the SAP BTP region is accessed directly.
+
+**Note:** Changing the proxy settings interrupts the tunnels of all subaccounts. Subaccounts that were connected before the change are connected again afterwards.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/configure-proxy-settings>`,
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host name of the HTTPS proxy.",
+				Required:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the HTTPS proxy.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User for authenticating against the proxy. Not required if the proxy accepts anonymous access.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: `Password of the proxy user.
+
+**Note:**
+- This value is write-only and **will not be persisted** in the Terraform state file.
+- Change the value of ` + "`password_wo_version`" + ` to send an updated password to the Cloud Connector.`,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("user")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the proxy password. The password given in `password_wo` is only sent to the Cloud Connector on creation and whenever this value changes.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"non_proxy_hosts": schema.ListAttribute{
+				MarkdownDescription: "Hosts that are accessed directly and not via the proxy. The wildcard `*` can be used at the beginning of a host name, for example `*.example.com`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ProxySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config ProxySettingsConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, diags := r.buildRequestBody(ctx, plan, config.PasswordWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchProxySettingsFailed, err.Error())
+		return
+	}
+
+	// Changing the proxy interrupts the tunnels, so the connected subaccounts are remembered to reconnect them afterwards
+	connected, err := connectedSubaccounts(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchConnectedSubaccountsFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgAddProxySettingsFailed, err.Error())
+		return
+	}
+
+	reconnectSubaccounts(r.client, connected, &resp.Diagnostics)
+
+	responseModel, diags := ProxySettingsValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapProxySettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProxySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProxySettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchProxySettingsFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := ProxySettingsValueFrom(ctx, state, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapProxySettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProxySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config ProxySettingsConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is only sent if its version changed, otherwise the Cloud Connector keeps the current one
+	password := types.StringNull()
+	if !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		password = config.PasswordWO
+	}
+
+	planBody, diags := r.buildRequestBody(ctx, plan, password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchProxySettingsFailed, err.Error())
+		return
+	}
+
+	connected, err := connectedSubaccounts(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchConnectedSubaccountsFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgUpdateProxySettingsFailed, err.Error())
+		return
+	}
+
+	reconnectSubaccounts(r.client, connected, &resp.Diagnostics)
+
+	responseModel, diags := ProxySettingsValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapProxySettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProxySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProxySettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connected, err := connectedSubaccounts(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchConnectedSubaccountsFailed, err.Error())
+		return
+	}
+
+	r.reset(&resp.Diagnostics, errMsgDeleteProxySettingsFailed)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reconnectSubaccounts(r.client, connected, &resp.Diagnostics)
+}
+
+func (r *ProxySettingsResource) buildRequestBody(ctx context.Context, plan ProxySettingsConfig, password types.String) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	planBody := map[string]any{
+		"proxyHost": plan.Host.ValueString(),
+		"proxyPort": plan.Port.ValueInt64(),
+	}
+
+	// Unknown values keep the current settings of the Cloud Connector
+	if !plan.User.IsUnknown() {
+		planBody["proxyUser"] = plan.User.ValueString()
+	}
+
+	if !plan.NonProxyHosts.IsUnknown() {
+		nonProxyHosts := []string{}
+		diags = plan.NonProxyHosts.ElementsAs(ctx, &nonProxyHosts, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+		planBody["nonProxyHosts"] = nonProxyHosts
+	}
+
+	if !password.IsNull() {
+		planBody["proxyPassword"] = password.ValueString()
+	}
+
+	return planBody, diags
+}
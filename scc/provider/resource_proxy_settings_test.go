@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestResourceProxySettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_proxy_settings")
+		if len(user.ProxyPassword) == 0 {
+			t.Fatalf("Missing TF_VAR_proxy_password for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceProxySettings("test", "proxy.example.com", 8080, "proxyuser", user.ProxyPassword, 1, `["localhost", "*.example.com"]`) +
+						DataSourceSubaccountConfigurationAfterProxy("connected", "9f7390c8-f201-4b2d-b751-04c0a63c2671") +
+						DataSourceSubaccountConfigurationAfterProxy("disconnected", "7480ee65-e039-41cf-ba72-6d9a0c7d2d4e"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "host", "proxy.example.com"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "port", "8080"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "user", "proxyuser"),
+						resource.TestCheckNoResourceAttr("scc_proxy_settings.test", "password_wo"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "password_wo_version", "1"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "non_proxy_hosts.#", "2"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "non_proxy_hosts.1", "*.example.com"),
+						// Only subaccounts that were connected before the change are reconnected
+						resource.TestCheckResourceAttr("data.scc_subaccount_configuration.connected", "tunnel.state", "Connected"),
+						resource.TestCheckResourceAttr("data.scc_subaccount_configuration.disconnected", "tunnel.state", "Disconnected"),
+					),
+				},
+				{
+					// Settings that are not configured keep their current value
+					Config: providerConfig(user) + ResourceProxySettingsWoOptionals("test", "proxy.example.com", 3128),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "port", "3128"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "user", "proxyuser"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "non_proxy_hosts.#", "2"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceProxySettingsWoUser("test", "proxy2.example.com", 3128),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "host", "proxy2.example.com"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "port", "3128"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "user", ""),
+						resource.TestCheckNoResourceAttr("scc_proxy_settings.test", "password_wo_version"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.test", "non_proxy_hosts.#", "0"),
+					),
+				},
+				{
+					ResourceName:                         "scc_proxy_settings.test",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "proxy_settings",
+					ImportStateVerifyIdentifierAttribute: "host",
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid port", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceProxySettingsWoUser("test", "proxy.example.com", 70000),
+					ExpectError: regexp.MustCompile(`(?is)Attribute port value must be between 1 and 65535`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - password without user", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					resource "scc_proxy_settings" "test" {
+					host = "proxy.example.com"
+					port = 8080
+					password_wo = "secret"
+					}
+					`,
+					ExpectError: regexp.MustCompile(`(?is)Attribute "user" must be specified when "password_wo" is\s+specified`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceProxySettings(resourceName string, host string, port int64, user string, password string, passwordVersion int64, nonProxyHosts string) string {
+	return fmt.Sprintf(`
+	resource "scc_proxy_settings" "%s" {
+	host = "%s"
+	port = %d
+	user = "%s"
+	password_wo = "%s"
+	password_wo_version = %d
+	non_proxy_hosts = %s
+	}
+	`, resourceName, host, port, user, password, passwordVersion, nonProxyHosts)
+}
+
+func ResourceProxySettingsWoUser(resourceName string, host string, port int64) string {
+	return fmt.Sprintf(`
+	resource "scc_proxy_settings" "%s" {
+	host = "%s"
+	port = %d
+	user = ""
+	non_proxy_hosts = []
+	}
+	`, resourceName, host, port)
+}
+
+func ResourceProxySettingsWoOptionals(resourceName string, host string, port int64) string {
+	return fmt.Sprintf(`
+	resource "scc_proxy_settings" "%s" {
+	host = "%s"
+	port = %d
+	}
+	`, resourceName, host, port)
+}
+
+func DataSourceSubaccountConfigurationAfterProxy(datasourceName string, subaccount string) string {
+	return fmt.Sprintf(`
+	data "scc_subaccount_configuration" "%s" {
+	region_host = "cf.eu12.hana.ondemand.com"
+	subaccount = "%s"
+	depends_on = [scc_proxy_settings.test]
+	}
+	`, datasourceName, subaccount)
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = &SNCSettingsResource{}
+
+func NewSNCSettingsResource() resource.Resource {
+	return &SNCSettingsResource{
+		singletonResource: singletonResource[apiobjects.SNCSettings]{
+			endpoint:        endpoints.GetSNCSettingsEndpoint(),
+			description:     "SNC settings",
+			resettable:      true,
+			importAttribute: "library_path",
+			importValue:     func(value apiobjects.SNCSettings) any { return value.LibraryPath },
+		},
+	}
+}
+
+type SNCSettingsResource struct {
+	singletonResource[apiobjects.SNCSettings]
+}
+
+func (r *SNCSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snc_settings"
+}
+
+func (r *SNCSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector SNC Settings Resource.
+
+Secure Network Communication (SNC) secures the RFC connections between the Cloud Connector and the on-premise ABAP systems. SNC must be configured before system mappings with the protocol ` + "`RFCS`" + ` can be used. There is only one SNC configuration per Cloud Connector instance. Deleting the resource removes the SNC configuration.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+* Add the SNC settings to the ` + "`depends_on`" + ` argument of system mappings with the protocol ` + "`RFCS`" + `, so that SNC is configured before the system mappings are created.
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/initial-configuration-rfc>`,
+		Attributes: map[string]schema.Attribute{
+			"library_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the SNC library on the Cloud Connector host, for example the SAP Cryptographic Library.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"my_name": schema.StringAttribute{
+				MarkdownDescription: "SNC name of the Cloud Connector, for example `p:CN=SCC, O=Example, C=DE`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"quality_of_protection": schema.StringAttribute{
+				MarkdownDescription: "Level of protection of the SNC connections. Valid values are:" +
+					getFormattedValueAsTableRow("value", "description") +
+					getFormattedValueAsTableRow("---", "---") +
+					getFormattedValueAsTableRow("`Authentication`", "The communication partners are authenticated.") +
+					getFormattedValueAsTableRow("`Integrity`", "The data is additionally protected against manipulation.") +
+					getFormattedValueAsTableRow("`Privacy`", "The data is additionally encrypted.") +
+					getFormattedValueAsTableRow("`Maximum`", "The maximum protection supported by the SNC library is used."),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Authentication", "Integrity", "Privacy", "Maximum"),
+				},
+			},
+		},
+	}
+}
+
+func (r *SNCSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SNCSettingsConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(r.buildRequestBody(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchSNCSettingsFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgAddSNCSettingsFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := SNCSettingsValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapSNCSettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SNCSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SNCSettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchSNCSettingsFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := SNCSettingsValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapSNCSettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SNCSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SNCSettingsConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(r.buildRequestBody(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchSNCSettingsFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgUpdateSNCSettingsFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := SNCSettingsValueFrom(ctx, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapSNCSettingsFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SNCSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SNCSettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reset(&resp.Diagnostics, errMsgDeleteSNCSettingsFailed)
+}
+
+func (r *SNCSettingsResource) buildRequestBody(plan SNCSettingsConfig) map[string]any {
+	planBody := map[string]any{
+		"libraryPath": plan.LibraryPath.ValueString(),
+		"myName":      plan.MyName.ValueString(),
+	}
+
+	// If not configured, the Cloud Connector keeps its default quality of protection
+	if !plan.QualityOfProtection.IsUnknown() {
+		planBody["qop"] = plan.QualityOfProtection.ValueString()
+	}
+
+	return planBody
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResourceSNCSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_snc_settings")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceSNCSettingsWoQualityOfProtection("test", "/usr/sap/scc/libsapcrypto.so", "p:CN=SCC, O=Example, C=DE"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_snc_settings.test", "library_path", "/usr/sap/scc/libsapcrypto.so"),
+						resource.TestCheckResourceAttr("scc_snc_settings.test", "my_name", "p:CN=SCC, O=Example, C=DE"),
+						resource.TestCheckResourceAttr("scc_snc_settings.test", "quality_of_protection", "Maximum"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceSNCSettings("test", "/opt/sap/scc/libsapcrypto.so", "p:CN=SCC-PROD, O=Example, C=DE", "Privacy"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_snc_settings.test", "library_path", "/opt/sap/scc/libsapcrypto.so"),
+						resource.TestCheckResourceAttr("scc_snc_settings.test", "my_name", "p:CN=SCC-PROD, O=Example, C=DE"),
+						resource.TestCheckResourceAttr("scc_snc_settings.test", "quality_of_protection", "Privacy"),
+					),
+				},
+				{
+					// Settings that are not configured keep their current value
+					Config: providerConfig(user) + ResourceSNCSettingsWoQualityOfProtection("test", "/opt/sap/scc/libsapcrypto.so", "p:CN=SCC-QA, O=Example, C=DE"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_snc_settings.test", "my_name", "p:CN=SCC-QA, O=Example, C=DE"),
+						resource.TestCheckResourceAttr("scc_snc_settings.test", "quality_of_protection", "Privacy"),
+					),
+				},
+				{
+					ResourceName:                         "scc_snc_settings.test",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "snc_settings",
+					ImportStateVerifyIdentifierAttribute: "library_path",
+				},
+			},
+		})
+	})
+
+	t.Run("error path - library path mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSNCSettingsWoLibraryPath("test", "p:CN=SCC, O=Example, C=DE"),
+					ExpectError: regexp.MustCompile(`The argument "library_path" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid quality of protection", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSNCSettings("test", "/usr/sap/scc/libsapcrypto.so", "p:CN=SCC, O=Example, C=DE", "Encryption"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute quality_of_protection value must be one of`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceSNCSettings(resourceName string, libraryPath string, myName string, qualityOfProtection string) string {
+	return fmt.Sprintf(`
+	resource "scc_snc_settings" "%s" {
+	library_path = "%s"
+	my_name = "%s"
+	quality_of_protection = "%s"
+	}
+	`, resourceName, libraryPath, myName, qualityOfProtection)
+}
+
+func ResourceSNCSettingsWoQualityOfProtection(resourceName string, libraryPath string, myName string) string {
+	return fmt.Sprintf(`
+	resource "scc_snc_settings" "%s" {
+	library_path = "%s"
+	my_name = "%s"
+	}
+	`, resourceName, libraryPath, myName)
+}
+
+func ResourceSNCSettingsWoLibraryPath(resourceName string, myName string) string {
+	return fmt.Sprintf(`
+	resource "scc_snc_settings" "%s" {
+	my_name = "%s"
+	}
+	`, resourceName, myName)
+}
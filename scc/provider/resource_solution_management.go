@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = &SolutionManagementResource{}
+
+func NewSolutionManagementResource() resource.Resource {
+	return &SolutionManagementResource{
+		singletonResource: singletonResource[apiobjects.SolutionManagement]{
+			endpoint:        endpoints.GetSolutionManagementEndpoint(),
+			description:     "solution management settings",
+			resettable:      true,
+			importAttribute: "enabled",
+			importValue:     func(value apiobjects.SolutionManagement) any { return value.Enabled },
+		},
+	}
+}
+
+type SolutionManagementResource struct {
+	singletonResource[apiobjects.SolutionManagement]
+}
+
+func (r *SolutionManagementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_solution_management"
+}
+
+func (r *SolutionManagementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Solution Management Resource.
+
+The Cloud Connector can be integrated into SAP Solution Manager for end-to-end monitoring. The integration uses the SAP Host Agent installed on the Cloud Connector host to register the Cloud Connector in the System Landscape Directory (SLD) or the Landscape Management Database (LMDB). There is only one solution management configuration per Cloud Connector instance. Deleting the resource disables the integration.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/configure-solution-management-integration>`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Boolean flag indicating whether the integration with SAP Solution Manager is enabled.",
+				Required:            true,
+			},
+			"host_agent_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the SAP Host Agent executable on the Cloud Connector host.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"target_system_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the system the Cloud Connector is registered in. Valid values are:" +
+					getFormattedValueAsTableRow("value", "description") +
+					getFormattedValueAsTableRow("---", "---") +
+					getFormattedValueAsTableRow("`SLD`", "System Landscape Directory") +
+					getFormattedValueAsTableRow("`LMDB`", "Landscape Management Database of SAP Solution Manager"),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("SLD", "LMDB"),
+				},
+			},
+			"target_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the SLD or LMDB the Cloud Connector is registered in.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("target_system_type")),
+				},
+			},
+			"registration_file_path": schema.StringAttribute{
+				MarkdownDescription: "Local path the registration file is downloaded to after the configuration has been created or updated. The file is only downloaded if the integration is enabled. This value is not stored in the Cloud Connector.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *SolutionManagementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SolutionManagementConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody := r.buildRequestBody(plan)
+
+	planBody, err := r.adopt(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchSolutionManagementFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgAddSolutionManagementFailed, err.Error())
+		return
+	}
+
+	if respObj.Enabled && !plan.RegistrationFilePath.IsNull() {
+		if err = r.downloadRegistrationFile(plan.RegistrationFilePath.ValueString()); err != nil {
+			resp.Diagnostics.AddError(errMsgDownloadSolutionManagementRegistrationFailed, err.Error())
+			return
+		}
+	}
+
+	responseModel, diags := SolutionManagementValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapSolutionManagementFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SolutionManagementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SolutionManagementConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respObj, err := r.fetch()
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchSolutionManagementFailed, err.Error())
+		return
+	}
+
+	responseModel, diags := SolutionManagementValueFrom(ctx, state, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapSolutionManagementFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SolutionManagementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SolutionManagementConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBody, err := r.adopt(r.buildRequestBody(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgFetchSolutionManagementFailed, err.Error())
+		return
+	}
+
+	respObj, err := r.write(planBody)
+	if err != nil {
+		resp.Diagnostics.AddError(errMsgUpdateSolutionManagementFailed, err.Error())
+		return
+	}
+
+	if respObj.Enabled && !plan.RegistrationFilePath.IsNull() {
+		if err = r.downloadRegistrationFile(plan.RegistrationFilePath.ValueString()); err != nil {
+			resp.Diagnostics.AddError(errMsgDownloadSolutionManagementRegistrationFailed, err.Error())
+			return
+		}
+	}
+
+	responseModel, diags := SolutionManagementValueFrom(ctx, plan, respObj)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(errMsgMapSolutionManagementFailed, fmt.Sprintf("%s", diags))
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SolutionManagementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SolutionManagementConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reset(&resp.Diagnostics, errMsgDeleteSolutionManagementFailed)
+}
+
+func (r *SolutionManagementResource) buildRequestBody(plan SolutionManagementConfig) map[string]any {
+	planBody := map[string]any{
+		"enabled": plan.Enabled.ValueBool(),
+	}
+
+	// Unknown values are left to the Cloud Connector defaults
+	if !plan.HostAgentPath.IsUnknown() {
+		planBody["hostAgentPath"] = plan.HostAgentPath.ValueString()
+	}
+
+	if !plan.TargetSystemType.IsUnknown() {
+		planBody["targetSystemType"] = plan.TargetSystemType.ValueString()
+	}
+
+	if !plan.TargetURL.IsUnknown() {
+		planBody["targetUrl"] = plan.TargetURL.ValueString()
+	}
+
+	return planBody
+}
+
+func (r *SolutionManagementResource) downloadRegistrationFile(filePath string) error {
+	response, err := sendGetRequest(r.client, endpoints.GetSolutionManagementRegistrationFileEndpoint())
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read registration file: %v", err)
+	}
+
+	if err = os.WriteFile(filePath, content, 0600); err != nil {
+		return fmt.Errorf("failed to write registration file to %s: %v", filePath, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceSolutionManagement(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_solution_management")
+		defer stopQuietly(rec)
+
+		registrationFilePath := filepath.Join(t.TempDir(), "scc_registration.txt")
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceSolutionManagement("test", true, "/usr/sap/hostctrl/exe", "SLD", "https://sld.example.com:50000", registrationFilePath),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_solution_management.test", "enabled", "true"),
+						resource.TestCheckResourceAttr("scc_solution_management.test", "host_agent_path", "/usr/sap/hostctrl/exe"),
+						resource.TestCheckResourceAttr("scc_solution_management.test", "target_system_type", "SLD"),
+						resource.TestCheckResourceAttr("scc_solution_management.test", "target_url", "https://sld.example.com:50000"),
+						resource.TestCheckResourceAttr("scc_solution_management.test", "registration_file_path", registrationFilePath),
+						checkRegistrationFileExists(registrationFilePath),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceSolutionManagement("test", true, "/usr/sap/hostctrl/exe", "LMDB", "https://solman.example.com:50001", registrationFilePath),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_solution_management.test", "enabled", "true"),
+						resource.TestCheckResourceAttr("scc_solution_management.test", "target_system_type", "LMDB"),
+						resource.TestCheckResourceAttr("scc_solution_management.test", "target_url", "https://solman.example.com:50001"),
+					),
+				},
+				{
+					ResourceName:                         "scc_solution_management.test",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "solution_management",
+					ImportStateVerifyIdentifierAttribute: "enabled",
+					ImportStateVerifyIgnore: []string{
+						"registration_file_path",
+					},
+				},
+				{
+					Config: providerConfig(user) + ResourceSolutionManagementWoTarget("test", false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_solution_management.test", "enabled", "false"),
+						// Settings that are not configured keep their current value
+						resource.TestCheckResourceAttr("scc_solution_management.test", "host_agent_path", "/usr/sap/hostctrl/exe"),
+						resource.TestCheckResourceAttr("scc_solution_management.test", "target_system_type", "LMDB"),
+						resource.TestCheckResourceAttr("scc_solution_management.test", "target_url", "https://solman.example.com:50001"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - enabled mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSolutionManagementWoEnabled("test", "/usr/sap/hostctrl/exe"),
+					ExpectError: regexp.MustCompile(`The argument "enabled" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid target system type", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSolutionManagement("test", true, "/usr/sap/hostctrl/exe", "CMDB", "https://sld.example.com:50000", "scc_registration.txt"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute target_system_type value must be one of`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - target url without target system type", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSolutionManagementWoTargetSystemType("test", true, "https://sld.example.com:50000"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute "target_system_type" must be specified when "target_url" is\s+specified`),
+				},
+			},
+		})
+	})
+}
+
+func checkRegistrationFileExists(filePath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("registration file was not downloaded: %v", err)
+		}
+
+		if len(content) == 0 {
+			return fmt.Errorf("registration file %s is empty", filePath)
+		}
+
+		return nil
+	}
+}
+
+func ResourceSolutionManagement(resourceName string, enabled bool, hostAgentPath string, targetSystemType string, targetURL string, registrationFilePath string) string {
+	return fmt.Sprintf(`
+	resource "scc_solution_management" "%s" {
+	enabled = %t
+	host_agent_path = "%s"
+	target_system_type = "%s"
+	target_url = "%s"
+	registration_file_path = "%s"
+	}
+	`, resourceName, enabled, hostAgentPath, targetSystemType, targetURL, registrationFilePath)
+}
+
+func ResourceSolutionManagementWoTarget(resourceName string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "scc_solution_management" "%s" {
+	enabled = %t
+	}
+	`, resourceName, enabled)
+}
+
+func ResourceSolutionManagementWoEnabled(resourceName string, hostAgentPath string) string {
+	return fmt.Sprintf(`
+	resource "scc_solution_management" "%s" {
+	host_agent_path = "%s"
+	}
+	`, resourceName, hostAgentPath)
+}
+
+func ResourceSolutionManagementWoTargetSystemType(resourceName string, enabled bool, targetURL string) string {
+	return fmt.Sprintf(`
+	resource "scc_solution_management" "%s" {
+	enabled = %t
+	target_url = "%s"
+	}
+	`, resourceName, enabled, targetURL)
+}
@@ -9,15 +9,20 @@ import (
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
 	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var _ resource.Resource = &SubaccountResource{}
+var _ resource.ResourceWithIdentity = &SubaccountResource{}
 
 func NewSubaccountResource() resource.Resource {
 	return &SubaccountResource{}
@@ -55,13 +60,37 @@ __Further documentation:__
 				},
 			},
 			"cloud_user": schema.StringAttribute{
-				MarkdownDescription: "User for the specified subaccount and region host.",
+				MarkdownDescription: "User for the specified subaccount and region host. Changing the user after creation refreshes the subaccount certificate. After an import, the user is only stored in the state.",
 				Required:            true,
 			},
 			"cloud_password": schema.StringAttribute{
-				MarkdownDescription: "Password for the cloud user.",
-				Sensitive:           true,
-				Required:            true,
+				MarkdownDescription: `Password for the cloud user. Changing the password after creation refreshes the subaccount certificate. After an import, the password is only stored in the state.
+
+**Note:**
+- This value **will be persisted** in the Terraform state file. Use ` + "`cloud_password_wo`" + ` to keep the password out of the state.
+- Exactly one of ` + "`cloud_password`" + ` and ` + "`cloud_password_wo`" + ` must be set.`,
+				Sensitive: true,
+				Optional:  true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("cloud_password"), path.MatchRoot("cloud_password_wo")),
+				},
+			},
+			"cloud_password_wo": schema.StringAttribute{
+				MarkdownDescription: `Password for the cloud user.
+
+**Note:**
+- This value is write-only and **will not be persisted** in the Terraform state file.
+- Change the value of ` + "`cloud_password_wo_version`" + ` to refresh the subaccount certificate with an updated password.`,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"cloud_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the cloud password. The password given in `cloud_password_wo` is only sent to the Cloud Connector on creation and whenever this value changes.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("cloud_password_wo")),
+				},
 			},
 			"location_id": schema.StringAttribute{
 				MarkdownDescription: "Location identifier for the Cloud Connector instance.",
@@ -78,6 +107,10 @@ __Further documentation:__
 				Computed:            true,
 				Optional:            true,
 			},
+			"sync_trust_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Whether the trust configuration of the subaccount is synchronized with the cloud whenever the subaccount is created or updated while its tunnel is connected. Defaults to `false`, so that plan and refresh only read from the Cloud Connector. Use the `scc_subaccount_sync_trust` action to synchronize the trust configuration on demand.",
+				Optional:            true,
+			},
 			"tunnel": schema.SingleNestedAttribute{
 				MarkdownDescription: "Details of connection tunnel used by the subaccount.",
 				Optional:            true,
@@ -181,6 +214,21 @@ __Further documentation:__
 	}
 }
 
+func (r *SubaccountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"region_host": identityschema.StringAttribute{
+				Description:       "Region Host Name.",
+				RequiredForImport: true,
+			},
+			"subaccount": identityschema.StringAttribute{
+				Description:       "The ID of the subaccount.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *SubaccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -201,7 +249,7 @@ func (r *SubaccountResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *SubaccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan SubaccountConfig
+	var plan, config SubaccountConfig
 	var respObj apiobjects.SubaccountResource
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -209,6 +257,12 @@ func (r *SubaccountResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	regionHost := plan.RegionHost.ValueString()
 	subaccount := plan.Subaccount.ValueString()
 
@@ -218,7 +272,7 @@ func (r *SubaccountResource) Create(ctx context.Context, req resource.CreateRequ
 		"regionHost":    regionHost,
 		"subaccount":    subaccount,
 		"cloudUser":     plan.CloudUser.ValueString(),
-		"cloudPassword": plan.CloudPassword.ValueString(),
+		"cloudPassword": cloudPassword(plan, config).ValueString(),
 		"description":   plan.Description.ValueString(),
 		"locationID":    plan.LocationID.ValueString(),
 		"displayName":   plan.DisplayName.ValueString(),
@@ -230,13 +284,10 @@ func (r *SubaccountResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	if respObj.Tunnel.State == "Connected" {
+	if plan.SyncTrustOnApply.ValueBool() && respObj.Tunnel.State == "Connected" {
 		// Trigger trust configuration sync for the subaccount without persisting to Terraform state
-		regionHost := respObj.RegionHost
-		subaccount := respObj.Subaccount
-
-		if err = r.syncTrustConfiguration(regionHost, subaccount, &respObj, &resp.Diagnostics); err != nil {
-			resp.Diagnostics.AddError(errMsgAddSubaccountFailed, err.Error())
+		if err = syncSubaccountTrust(r.client, respObj.RegionHost, respObj.Subaccount); err != nil {
+			resp.Diagnostics.AddError(errMsgSyncSubaccountTrustFailed, err.Error())
 			return
 		}
 	}
@@ -252,6 +303,8 @@ func (r *SubaccountResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SubaccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -273,14 +326,6 @@ func (r *SubaccountResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	if respObj.Tunnel.State == "Connected" {
-		// Trigger trust configuration sync for the subaccount without persisting to Terraform state
-		if err = r.syncTrustConfiguration(regionHost, subaccount, &respObj, &resp.Diagnostics); err != nil {
-			resp.Diagnostics.AddError(errMsgAddSubaccountFailed, err.Error())
-			return
-		}
-	}
-
 	responseModel, diags := SubaccountResourceValueFrom(ctx, state, respObj)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(errMsgMapSubaccountFailed, fmt.Sprintf("%s", diags))
@@ -292,10 +337,12 @@ func (r *SubaccountResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SubaccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state SubaccountConfig
+	var plan, state, config SubaccountConfig
 	var respObj apiobjects.SubaccountResource
 
 	if diags := req.Plan.Get(ctx, &plan); appendAndCheckErrors(&resp.Diagnostics, diags) {
@@ -306,6 +353,10 @@ func (r *SubaccountResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if diags := req.Config.Get(ctx, &config); appendAndCheckErrors(&resp.Diagnostics, diags) {
+		return
+	}
+
 	if err := validateUpdateInputs(plan, state); err != nil {
 		resp.Diagnostics.AddError(errMsgUpdateSubaccountFailed, err.Error())
 		return
@@ -327,16 +378,23 @@ func (r *SubaccountResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if shouldRefreshCertificate(plan, state) {
+		if err := r.refreshCertificate(plan, config, endpoint, &respObj); err != nil {
+			resp.Diagnostics.AddError(errMsgRefreshSubaccountCertificateFailed, err.Error())
+			return
+		}
+	}
+
 	if shouldUpdateTunnel(plan) {
 		if err := r.updateTunnelState(ctx, plan, state, endpoint, &respObj, &resp.Diagnostics); err != nil {
 			return
 		}
 	}
 
-	if respObj.Tunnel.State == "Connected" {
+	if plan.SyncTrustOnApply.ValueBool() && respObj.Tunnel.State == "Connected" {
 		// Trigger trust configuration sync for the subaccount without persisting to Terraform state
-		if err = r.syncTrustConfiguration(regionHost, subaccount, &respObj, &resp.Diagnostics); err != nil {
-			resp.Diagnostics.AddError(errMsgAddSubaccountFailed, err.Error())
+		if err = syncSubaccountTrust(r.client, regionHost, subaccount); err != nil {
+			resp.Diagnostics.AddError(errMsgSyncSubaccountTrustFailed, err.Error())
 			return
 		}
 	}
@@ -345,6 +403,7 @@ func (r *SubaccountResource) Update(ctx context.Context, req resource.UpdateRequ
 		resp.Diagnostics.AddError(errMsgMapSubaccountFailed, fmt.Sprintf("%s", diags))
 	} else {
 		resp.Diagnostics.Append(resp.State.Set(ctx, responseModel)...)
+		setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	}
 }
 
@@ -355,14 +414,46 @@ func appendAndCheckErrors(diags *diag.Diagnostics, newDiags diag.Diagnostics) bo
 
 func validateUpdateInputs(plan, state SubaccountConfig) error {
 	if plan.RegionHost.ValueString() != state.RegionHost.ValueString() ||
-		plan.Subaccount.ValueString() != state.Subaccount.ValueString() ||
-		plan.CloudUser.ValueString() != state.CloudUser.ValueString() ||
-		plan.CloudPassword.ValueString() != state.CloudPassword.ValueString() {
+		plan.Subaccount.ValueString() != state.Subaccount.ValueString() {
 		return fmt.Errorf("failed to update the cloud connector subaccount due to mismatched configuration values")
 	}
 	return nil
 }
 
+// shouldRefreshCertificate reports whether the cloud credentials changed since the creation of the subaccount.
+// Imported subaccounts have no credentials in the state, so they are only stored without refreshing the certificate.
+func shouldRefreshCertificate(plan, state SubaccountConfig) bool {
+	if state.CloudUser.IsNull() {
+		return false
+	}
+
+	return !plan.CloudUser.Equal(state.CloudUser) ||
+		!plan.CloudPassword.Equal(state.CloudPassword) ||
+		!plan.CloudPasswordWOVersion.Equal(state.CloudPasswordWOVersion)
+}
+
+// cloudPassword returns the write-only password if configured and the password stored in the plan otherwise.
+func cloudPassword(plan, config SubaccountConfig) types.String {
+	if !config.CloudPasswordWO.IsNull() {
+		return config.CloudPasswordWO
+	}
+	return plan.CloudPassword
+}
+
+func (r *SubaccountResource) refreshCertificate(plan, config SubaccountConfig, endpoint string, respObj *apiobjects.SubaccountResource) error {
+	body := map[string]string{
+		"user":     plan.CloudUser.ValueString(),
+		"password": cloudPassword(plan, config).ValueString(),
+	}
+
+	if err := requestAndUnmarshal(r.client, respObj, "POST", endpoint+"/validity", body, false); err != nil {
+		return err
+	}
+
+	// Re-fetch to update the subaccount certificate
+	return requestAndUnmarshal(r.client, respObj, "GET", endpoint, nil, true)
+}
+
 func shouldUpdateTunnel(plan SubaccountConfig) bool {
 	return !plan.Tunnel.IsNull() && !plan.Tunnel.IsUnknown()
 }
@@ -399,18 +490,6 @@ func (r *SubaccountResource) updateTunnelState(ctx context.Context, plan, state
 	return nil
 }
 
-func (r *SubaccountResource) syncTrustConfiguration(regionHost, subaccount string, respObj *apiobjects.SubaccountResource, diagnostics *diag.Diagnostics) error {
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount) + "/trust"
-
-	err := requestAndUnmarshal(r.client, &respObj, "POST", endpoint, nil, false)
-	if err != nil {
-		diagnostics.AddError(errMsgAddSubaccountFailed, err.Error())
-		return err
-	}
-
-	return nil
-}
-
 func (r *SubaccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state SubaccountConfig
 	var respObj apiobjects.SubaccountResource
@@ -444,6 +523,10 @@ func (r *SubaccountResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (rs *SubaccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if importStateFromIdentity(ctx, req, resp) {
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +22,8 @@ import (
 )
 
 var _ resource.Resource = &SubaccountABAPServiceChannelResource{}
+var _ resource.ResourceWithIdentity = &SubaccountABAPServiceChannelResource{}
+var _ resource.ResourceWithModifyPlan = &SubaccountABAPServiceChannelResource{}
 
 func NewSubaccountABAPServiceChannelResource() resource.Resource {
 	return &SubaccountABAPServiceChannelResource{}
@@ -121,6 +124,25 @@ __Further documentation:__
 	}
 }
 
+func (r *SubaccountABAPServiceChannelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"region_host": identityschema.StringAttribute{
+				Description:       "Region Host Name.",
+				RequiredForImport: true,
+			},
+			"subaccount": identityschema.StringAttribute{
+				Description:       "The ID of the subaccount.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.Int64Attribute{
+				Description:       "Unique identifier for the subaccount service channel (a positive integer number, starting with 1). This identifier is unique across all types of service channels.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *SubaccountABAPServiceChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
@@ -141,6 +163,10 @@ func (r *SubaccountABAPServiceChannelResource) Configure(ctx context.Context, re
 	r.client = client
 }
 
+func (r *SubaccountABAPServiceChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateConnectorCapabilities(ctx, r.client, "scc_subaccount_abap_service_channel", req, resp)
+}
+
 func (r *SubaccountABAPServiceChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan SubaccountABAPServiceChannelConfig
 	var respObj apiobjects.SubaccountABAPServiceChannels
@@ -203,6 +229,8 @@ func (r *SubaccountABAPServiceChannelResource) Create(ctx context.Context, req r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SubaccountABAPServiceChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -236,6 +264,8 @@ func (r *SubaccountABAPServiceChannelResource) Read(ctx context.Context, req res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SubaccountABAPServiceChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -291,6 +321,8 @@ func (r *SubaccountABAPServiceChannelResource) Update(ctx context.Context, req r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SubaccountABAPServiceChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -364,6 +396,10 @@ func (r *SubaccountABAPServiceChannelResource) updateSubaccountABAPServiceChanne
 }
 
 func (rs *SubaccountABAPServiceChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if importStateFromIdentity(ctx, req, resp) {
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
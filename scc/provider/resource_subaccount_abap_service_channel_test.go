@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestResourceSubaccountABAPServiceChannel(t *testing.T) {
@@ -109,6 +112,50 @@ func TestResourceSubaccountABAPServiceChannel(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - import by identity", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_abap_service_channel_import_identity")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_12_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + importBlockWithIdentity("scc_subaccount_abap_service_channel.test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\tsubaccount = \"9f7390c8-f201-4b2d-b751-04c0a63c2671\"\n\tid = 52") + ResourceSubaccountABAPServiceChannel("test", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", user.ABAPCloudTenantHost, 50, 1, false, ""),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount_abap_service_channel.test", "port", "3350"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("scc_subaccount_abap_service_channel.test", map[string]knownvalue.Check{
+							"region_host": knownvalue.StringExact("cf.eu12.hana.ondemand.com"),
+							"subaccount":  knownvalue.StringExact("9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+							"id":          knownvalue.Int64Exact(52),
+						}),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - unsupported connector version", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_abap_service_channel_err_unsupported_version")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config:      providerConfig(user) + ResourceSubaccountABAPServiceChannel("test", regionHost, subaccount, abapCloudTenantHost, 20, 1, true, "Created"),
+					ExpectError: regexp.MustCompile(`(?s)The resource scc_subaccount_abap_service_channel requires Cloud Connector\s+version 2\.15\.0 or higher, but the connected Cloud Connector has version\s+2\.14\.0`),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - region host mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestResourceSubaccountK8SServiceChannel(t *testing.T) {
@@ -104,6 +107,34 @@ func TestResourceSubaccountK8SServiceChannel(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - import by identity", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_k8s_service_channel_import_identity")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_12_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + importBlockWithIdentity("scc_subaccount_k8s_service_channel.test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\tsubaccount = \"9f7390c8-f201-4b2d-b751-04c0a63c2671\"\n\tid = 51") + ResourceSubaccountK8SServiceChannel("test", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", user.K8SCluster, user.K8SService, 3000, 1, false, ""),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount_k8s_service_channel.test", "local_port", "3000"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("scc_subaccount_k8s_service_channel.test", map[string]knownvalue.Check{
+							"region_host": knownvalue.StringExact("cf.eu12.hana.ondemand.com"),
+							"subaccount":  knownvalue.StringExact("9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+							"id":          knownvalue.Int64Exact(51),
+						}),
+					},
+				},
+			},
+		})
+	})
+
 	t.Run("error path - region host mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
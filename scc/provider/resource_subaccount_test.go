@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestResourceSubaccount(t *testing.T) {
@@ -112,6 +115,35 @@ func TestResourceSubaccount(t *testing.T) {
 		})
 	})
 
+	t.Run("update path - sync trust on apply", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_sync_trust")
+		if len(user.CloudUsername) == 0 || len(user.CloudPassword) == 0 {
+			t.Fatalf("Missing TF_VAR_cloud_user or TF_VAR_cloud_password for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceSubaccountWithSyncTrust("test", regionHost, subaccountId, user.CloudUsername, user.CloudPassword, "Initial description", true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount.test", "sync_trust_on_apply", "true"),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "tunnel.state", "Connected"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceSubaccountWithSyncTrust("test", regionHost, subaccountId, user.CloudUsername, user.CloudPassword, "Updated description", false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount.test", "sync_trust_on_apply", "false"),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "description", "Updated description"),
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("update path - tunnel state change", func(t *testing.T) {
 		rec, user := setupVCR(t, "fixtures/resource_subaccount_update_tunnel")
 		if user.CloudUsername == "" || user.CloudPassword == "" {
@@ -150,6 +182,125 @@ func TestResourceSubaccount(t *testing.T) {
 		})
 	})
 
+	t.Run("update path - credentials change refreshes certificate", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_update_credentials")
+		if len(user.CloudUsername) == 0 || len(user.CloudPassword) == 0 {
+			t.Fatalf("Missing TF_VAR_cloud_user or TF_VAR_cloud_password for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceSubaccount("test", regionHost, subaccountId, user.CloudUsername, user.CloudPassword, "subaccount added via terraform tests"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount.test", "cloud_password", user.CloudPassword),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceSubaccount("test", regionHost, subaccountId, user.CloudUsername, user.CloudPassword+"-rotated", "subaccount added via terraform tests"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount.test", "cloud_password", user.CloudPassword+"-rotated"),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "tunnel.state", "Connected"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("update path - write-only cloud password", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_write_only_password")
+		if len(user.CloudUsername) == 0 || len(user.CloudPassword) == 0 {
+			t.Fatalf("Missing TF_VAR_cloud_user or TF_VAR_cloud_password for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceSubaccountWriteOnlyPassword("test", regionHost, subaccountId, user.CloudUsername, user.CloudPassword, 1),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("scc_subaccount.test", "cloud_password"),
+						resource.TestCheckNoResourceAttr("scc_subaccount.test", "cloud_password_wo"),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "cloud_password_wo_version", "1"),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "tunnel.state", "Connected"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceSubaccountWriteOnlyPassword("test", regionHost, subaccountId, user.CloudUsername, user.CloudPassword+"-rotated", 2),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("scc_subaccount.test", "cloud_password"),
+						resource.TestCheckNoResourceAttr("scc_subaccount.test", "cloud_password_wo"),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "cloud_password_wo_version", "2"),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "tunnel.state", "Connected"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("update path - imported subaccount stores credentials", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_import_credentials")
+		if len(user.CloudUsername) == 0 || len(user.CloudPassword) == 0 {
+			t.Fatalf("Missing TF_VAR_cloud_user or TF_VAR_cloud_password for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceSubaccountImport("test", regionHost, "9f7390c8-f201-4b2d-b751-04c0a63c2671", user.CloudUsername, user.CloudPassword),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount.test", "subaccount", "9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "cloud_user", user.CloudUsername),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "cloud_password", user.CloudPassword),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "tunnel.state", "Connected"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("update path - import by identity", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_import_identity")
+		if len(user.CloudUsername) == 0 || len(user.CloudPassword) == 0 {
+			t.Fatalf("Missing TF_VAR_cloud_user or TF_VAR_cloud_password for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_12_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + importBlockWithIdentity("scc_subaccount.test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\tsubaccount = \"9f7390c8-f201-4b2d-b751-04c0a63c2671\"") + ResourceSubaccount("test", regionHost, "9f7390c8-f201-4b2d-b751-04c0a63c2671", user.CloudUsername, user.CloudPassword, ""),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount.test", "cloud_user", user.CloudUsername),
+						resource.TestCheckResourceAttr("scc_subaccount.test", "tunnel.state", "Connected"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("scc_subaccount.test", map[string]knownvalue.Check{
+							"region_host": knownvalue.StringExact("cf.eu12.hana.ondemand.com"),
+							"subaccount":  knownvalue.StringExact("9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+						}),
+					},
+				},
+			},
+		})
+	})
+
 	t.Run("error path - region host mandatory", func(t *testing.T) {
 		rec, user := setupVCR(t, "fixtures/resource_subaccount_err_wo_region_host")
 
@@ -219,7 +370,7 @@ func TestResourceSubaccount(t *testing.T) {
 			Steps: []resource.TestStep{
 				{
 					Config:      ResourceSubaccountWoPassword("test", "cf.eu12.hana.ondemand.com", "4916a705-273c-45a6-a2f0-08c234c7a23d", user.CloudUsername, "subaccount added via terraform tests"),
-					ExpectError: regexp.MustCompile(`The argument "cloud_password" is required, but no definition was found.`),
+					ExpectError: regexp.MustCompile(`(?s)No attribute specified when one \(and only one\) of\s+\[cloud_password,cloud_password_wo\] is required`),
 				},
 			},
 		})
@@ -282,6 +433,19 @@ func ResourceSubaccountWoPassword(datasourceName string, regionHost string, suba
 	`, datasourceName, regionHost, subaccount, cloudUser, description)
 }
 
+func ResourceSubaccountWriteOnlyPassword(datasourceName, regionHost, subaccount, cloudUser, cloudPassword string, cloudPasswordVersion int64) string {
+	return fmt.Sprintf(`
+	resource "scc_subaccount" "%s" {
+    region_host= "%s"
+    subaccount= "%s"
+    cloud_user= "%s"
+    cloud_password_wo= "%s"
+    cloud_password_wo_version= %d
+    description= "subaccount added via terraform tests"
+	}
+	`, datasourceName, regionHost, subaccount, cloudUser, cloudPassword, cloudPasswordVersion)
+}
+
 func ResourceSubaccountUpdateWithDisplayName(datasourceName, regionHost, subaccount, cloudUser, cloudPassword, description, displayName string) string {
 	return fmt.Sprintf(`
 resource "scc_subaccount" "%s" {
@@ -295,6 +459,19 @@ resource "scc_subaccount" "%s" {
 `, datasourceName, regionHost, subaccount, cloudUser, cloudPassword, description, displayName)
 }
 
+func ResourceSubaccountWithSyncTrust(datasourceName, regionHost, subaccount, cloudUser, cloudPassword, description string, syncTrust bool) string {
+	return fmt.Sprintf(`
+resource "scc_subaccount" "%s" {
+  region_host         = "%s"
+  subaccount          = "%s"
+  cloud_user          = "%s"
+  cloud_password      = "%s"
+  description         = "%s"
+  sync_trust_on_apply = %t
+}
+`, datasourceName, regionHost, subaccount, cloudUser, cloudPassword, description, syncTrust)
+}
+
 func ResourceSubaccountWithTunnelState(datasourceName, regionHost, subaccount, cloudUser, cloudPassword, description, tunnelState string) string {
 	return fmt.Sprintf(`
 resource "scc_subaccount" "%s" {
@@ -323,3 +500,19 @@ func getImportStateForSubaccount(resourceName string) resource.ImportStateIdFunc
 		), nil
 	}
 }
+
+func ResourceSubaccountImport(datasourceName, regionHost, subaccount, cloudUser, cloudPassword string) string {
+	return fmt.Sprintf(`
+	import {
+	to = scc_subaccount.%[1]s
+	id = "%[2]s,%[3]s"
+	}
+
+	resource "scc_subaccount" "%[1]s" {
+	region_host = "%[2]s"
+	subaccount = "%[3]s"
+	cloud_user = "%[4]s"
+	cloud_password = "%[5]s"
+	}
+	`, datasourceName, regionHost, subaccount, cloudUser, cloudPassword)
+}
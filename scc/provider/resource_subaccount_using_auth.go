@@ -9,10 +9,14 @@ import (
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
 	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -20,6 +24,8 @@ import (
 )
 
 var _ resource.Resource = &SubaccountUsingAuthResource{}
+var _ resource.ResourceWithModifyPlan = &SubaccountUsingAuthResource{}
+var _ resource.ResourceWithIdentity = &SubaccountUsingAuthResource{}
 
 func NewSubaccountUsingAuthResource() resource.Resource {
 	return &SubaccountUsingAuthResource{}
@@ -62,14 +68,39 @@ This value must be downloaded from the subaccount and used within **5 minutes**,
 is **not required** for updating optional attributes such as location_id, display_name, description or tunnel.  
 
 **Note:**  
-- This value **will be persisted** in the Terraform state file. It is the user's responsibility to keep the state file secure.  
-- If this value is updated, **the resource will be recreated**.`,
-				Required:  true,
+- This value **will be persisted** in the Terraform state file. It is the user's responsibility to keep the state file secure. Use ` + "`authentication_data_wo`" + ` to keep the authentication data out of the state.  
+- If this value is updated, **the resource will be recreated**.  
+- Exactly one of ` + "`authentication_data`" + ` and ` + "`authentication_data_wo`" + ` must be set.`,
+				Optional:  true,
 				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("authentication_data"), path.MatchRoot("authentication_data_wo")),
+				},
+			},
+			"authentication_data_wo": schema.StringAttribute{
+				MarkdownDescription: `Subaccount authentication data, used instead of cloud_user, cloud_password, subaccount and region_host (as of version 2.17.0).
+This value must be downloaded from the subaccount and used within **5 minutes**, as it expires shortly after generation. It is used only during **resource creation**.
+
+**Note:**  
+- This value is write-only and **will not be persisted** in the Terraform state file.  
+- Change the value of ` + "`authentication_data_wo_version`" + ` to recreate the resource with updated authentication data.`,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"authentication_data_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the authentication data. If this value is updated, **the resource will be recreated** using the authentication data given in `authentication_data_wo`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("authentication_data_wo")),
+				},
 			},
 			"location_id": schema.StringAttribute{
 				MarkdownDescription: "Location identifier for the Cloud Connector instance.",
@@ -86,6 +117,10 @@ is **not required** for updating optional attributes such as location_id, displa
 				Computed:            true,
 				Optional:            true,
 			},
+			"sync_trust_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Whether the trust configuration of the subaccount is synchronized with the cloud whenever the subaccount is created or updated while its tunnel is connected. Defaults to `false`, so that plan and refresh only read from the Cloud Connector. Use the `scc_subaccount_sync_trust` action to synchronize the trust configuration on demand.",
+				Optional:            true,
+			},
 			"tunnel": schema.SingleNestedAttribute{
 				MarkdownDescription: "Details of connection tunnel used by the subaccount.",
 				Optional:            true,
@@ -189,6 +224,21 @@ is **not required** for updating optional attributes such as location_id, displa
 	}
 }
 
+func (r *SubaccountUsingAuthResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"region_host": identityschema.StringAttribute{
+				Description:       "Region Host Name.",
+				RequiredForImport: true,
+			},
+			"subaccount": identityschema.StringAttribute{
+				Description:       "The ID of the subaccount.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *SubaccountUsingAuthResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -208,8 +258,12 @@ func (r *SubaccountUsingAuthResource) Configure(ctx context.Context, req resourc
 	r.client = client
 }
 
+func (r *SubaccountUsingAuthResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateConnectorCapabilities(ctx, r.client, "scc_subaccount_using_auth", req, resp)
+}
+
 func (r *SubaccountUsingAuthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan SubaccountUsingAuthConfig
+	var plan, config SubaccountUsingAuthConfig
 	var respObj apiobjects.SubaccountUsingAuthResource
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -217,10 +271,21 @@ func (r *SubaccountUsingAuthResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authenticationData := plan.AuthenticationData
+	if !config.AuthenticationDataWO.IsNull() {
+		authenticationData = config.AuthenticationDataWO
+	}
+
 	endpoint := endpoints.GetSubaccountBaseEndpoint()
 
 	planBody := map[string]string{
-		"authenticationData": plan.AuthenticationData.ValueString(),
+		"authenticationData": authenticationData.ValueString(),
 		"description":        plan.Description.ValueString(),
 		"locationID":         plan.LocationID.ValueString(),
 		"displayName":        plan.DisplayName.ValueString(),
@@ -232,13 +297,10 @@ func (r *SubaccountUsingAuthResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	if respObj.Tunnel.State == "Connected" {
+	if plan.SyncTrustOnApply.ValueBool() && respObj.Tunnel.State == "Connected" {
 		// Trigger trust configuration sync for the subaccount without persisting to Terraform state
-		regionHost := respObj.RegionHost
-		subaccount := respObj.Subaccount
-
-		if err = r.syncTrustConfiguration(regionHost, subaccount, &respObj, &resp.Diagnostics); err != nil {
-			resp.Diagnostics.AddError(errMsgAddSubaccountFailed, err.Error())
+		if err = syncSubaccountTrust(r.client, respObj.RegionHost, respObj.Subaccount); err != nil {
+			resp.Diagnostics.AddError(errMsgSyncSubaccountTrustFailed, err.Error())
 			return
 		}
 	}
@@ -254,6 +316,8 @@ func (r *SubaccountUsingAuthResource) Create(ctx context.Context, req resource.C
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SubaccountUsingAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -275,14 +339,6 @@ func (r *SubaccountUsingAuthResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	if respObj.Tunnel.State == "Connected" {
-		// Trigger trust configuration sync for the subaccount without persisting to Terraform state
-		if err = r.syncTrustConfiguration(regionHost, subaccount, &respObj, &resp.Diagnostics); err != nil {
-			resp.Diagnostics.AddError(errMsgAddSubaccountFailed, err.Error())
-			return
-		}
-	}
-
 	responseModel, diags := SubaccountUsingAuthResourceValueFrom(ctx, state, respObj)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(errMsgMapSubaccountFailed, fmt.Sprintf("%s", diags))
@@ -294,6 +350,8 @@ func (r *SubaccountUsingAuthResource) Read(ctx context.Context, req resource.Rea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SubaccountUsingAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -330,10 +388,10 @@ func (r *SubaccountUsingAuthResource) Update(ctx context.Context, req resource.U
 		}
 	}
 
-	if respObj.Tunnel.State == "Connected" {
+	if plan.SyncTrustOnApply.ValueBool() && respObj.Tunnel.State == "Connected" {
 		// Trigger trust configuration sync for the subaccount without persisting to Terraform state
-		if err = r.syncTrustConfiguration(regionHost, subaccount, &respObj, &resp.Diagnostics); err != nil {
-			resp.Diagnostics.AddError(errMsgAddSubaccountFailed, err.Error())
+		if err = syncSubaccountTrust(r.client, regionHost, subaccount); err != nil {
+			resp.Diagnostics.AddError(errMsgSyncSubaccountTrustFailed, err.Error())
 			return
 		}
 	}
@@ -342,6 +400,7 @@ func (r *SubaccountUsingAuthResource) Update(ctx context.Context, req resource.U
 		resp.Diagnostics.AddError(errMsgMapSubaccountFailed, fmt.Sprintf("%s", diags))
 	} else {
 		resp.Diagnostics.Append(resp.State.Set(ctx, responseModel)...)
+		setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	}
 }
 
@@ -386,18 +445,6 @@ func (r *SubaccountUsingAuthResource) updateTunnelState(ctx context.Context, pla
 	return nil
 }
 
-func (r *SubaccountUsingAuthResource) syncTrustConfiguration(regionHost, subaccount string, respObj *apiobjects.SubaccountUsingAuthResource, diagnostics *diag.Diagnostics) error {
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount) + "/trust"
-
-	err := requestAndUnmarshal(r.client, &respObj, "POST", endpoint, nil, false)
-	if err != nil {
-		diagnostics.AddError(errMsgAddSubaccountFailed, err.Error())
-		return err
-	}
-
-	return nil
-}
-
 func (r *SubaccountUsingAuthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state SubaccountUsingAuthConfig
 	var respObj apiobjects.SubaccountUsingAuthResource
@@ -431,6 +478,10 @@ func (r *SubaccountUsingAuthResource) Delete(ctx context.Context, req resource.D
 }
 
 func (rs *SubaccountUsingAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if importStateFromIdentity(ctx, req, resp) {
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestResourceSubaccountUsingAuth(t *testing.T) {
@@ -134,6 +135,58 @@ func TestResourceSubaccountUsingAuth(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - write-only authentication data", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_using_auth_write_only")
+		if len(user.CloudAuthenticationData) == 0 {
+			t.Fatalf("Missing TF_VAR_authentication_data for recording test fixtures")
+		}
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceSubaccountUsingAuthWriteOnly("test", user.CloudAuthenticationData, 1),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount_using_auth.test", "region_host", "cf.eu12.hana.ondemand.com"),
+						resource.TestMatchResourceAttr("scc_subaccount_using_auth.test", "subaccount", regexpValidUUID),
+						resource.TestCheckNoResourceAttr("scc_subaccount_using_auth.test", "authentication_data"),
+						resource.TestCheckNoResourceAttr("scc_subaccount_using_auth.test", "authentication_data_wo"),
+						resource.TestCheckResourceAttr("scc_subaccount_using_auth.test", "authentication_data_wo_version", "1"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceSubaccountUsingAuthWriteOnly("test", user.CloudAuthenticationData, 2),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("scc_subaccount_using_auth.test", "subaccount", regexpValidUUID),
+						resource.TestCheckNoResourceAttr("scc_subaccount_using_auth.test", "authentication_data_wo"),
+						resource.TestCheckResourceAttr("scc_subaccount_using_auth.test", "authentication_data_wo_version", "2"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - unsupported connector version", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_using_auth_err_unsupported_version")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config:      providerConfig(user) + ResourceSubaccountUsingAuth("test", user.CloudAuthenticationData, "subaccount added via terraform tests"),
+					ExpectError: regexp.MustCompile(`(?s)The attribute authentication_data of the resource scc_subaccount_using_auth\s+requires Cloud Connector version 2\.17\.0 or higher, but the connected Cloud\s+Connector has version 2\.14\.0`),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - authentication data mandatory", func(t *testing.T) {
 		rec, _ := setupVCR(t, "fixtures/resource_subaccount_using_auth_err_wo_authentication_data")
 		defer stopQuietly(rec)
@@ -143,7 +196,7 @@ func TestResourceSubaccountUsingAuth(t *testing.T) {
 			Steps: []resource.TestStep{
 				{
 					Config:      ResourceSubaccountUsingAuthWoAuthenticationData("test", "subaccount added via terraform tests"),
-					ExpectError: regexp.MustCompile(`The argument "authentication_data" is required, but no definition was found.`),
+					ExpectError: regexp.MustCompile(`(?s)No attribute specified when one \(and only one\) of\s+\[authentication_data,authentication_data_wo\] is required`),
 				},
 			},
 		})
@@ -167,6 +220,16 @@ func ResourceSubaccountUsingAuthWoAuthenticationData(datasourceName, description
 	`, datasourceName, description)
 }
 
+func ResourceSubaccountUsingAuthWriteOnly(datasourceName, authenticationData string, authenticationDataVersion int64) string {
+	return fmt.Sprintf(`
+	resource "scc_subaccount_using_auth" "%s" {
+    authentication_data_wo = "%s"
+    authentication_data_wo_version = %d
+    description= "subaccount added via terraform tests"
+	}
+	`, datasourceName, authenticationData, authenticationDataVersion)
+}
+
 func ResourceSubaccountUsingAuthUpdateWithDisplayName(datasourceName, authenticationData, description, displayName string) string {
 	return fmt.Sprintf(`
 resource "scc_subaccount_using_auth" "%s" {
@@ -9,8 +9,10 @@ import (
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
 	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -18,6 +20,8 @@ import (
 )
 
 var _ resource.Resource = &SystemMappingResource{}
+var _ resource.ResourceWithIdentity = &SystemMappingResource{}
+var _ resource.ResourceWithValidateConfig = &SystemMappingResource{}
 
 func NewSystemMappingResource() resource.Resource {
 	return &SystemMappingResource{}
@@ -35,6 +39,10 @@ func (r *SystemMappingResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Cloud Connector System Mapping Resource.
 				
+**Note:** Changing ` + "`virtual_host`" + ` or ` + "`virtual_port`" + ` replaces the system mapping, and the Cloud Connector deletes the resources of the system mapping together with it. The resources are not migrated to the new system mapping:
+* Resources managed by ` + "`scc_system_mapping_resource`" + ` are only recreated if their ` + "`virtual_host`" + ` and ` + "`virtual_port`" + ` reference the attributes of this resource.
+* Resources that are not managed by Terraform are lost.
+
 __Tips:__
 * You must be assigned to the following roles:
 	* Administrator
@@ -55,17 +63,19 @@ __Further documentation:__
 				},
 			},
 			"virtual_host": schema.StringAttribute{
-				MarkdownDescription: "Virtual host used on the cloud side. Cannot be updated after creation.",
+				MarkdownDescription: "Virtual host used on the cloud side. Changing the virtual host replaces the system mapping, which deletes all resources of the system mapping that are not managed with `scc_system_mapping_resource`.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"virtual_port": schema.StringAttribute{
-				MarkdownDescription: "Virtual port used on the cloud side.",
+				MarkdownDescription: "Virtual port used on the cloud side. Changing the virtual port replaces the system mapping, which deletes all resources of the system mapping that are not managed with `scc_system_mapping_resource`.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"internal_host": schema.StringAttribute{
@@ -82,51 +92,40 @@ __Further documentation:__
 			},
 			"protocol": schema.StringAttribute{
 				MarkdownDescription: "Protocol used when sending requests and receiving responses, which must be one of the following values:" +
-					getFormattedValueAsTableRow("protocol", "description") +
-					getFormattedValueAsTableRow("---", "---") +
-					getFormattedValueAsTableRow("HTTP", "HTTP protocol") +
-					getFormattedValueAsTableRow("HTTPS", "Secure HTTP protocol") +
-					getFormattedValueAsTableRow("RFC", "Remote Function Call protocol") +
-					getFormattedValueAsTableRow("RFCS", "Secure RFC protocol") +
-					getFormattedValueAsTableRow("LDAP", "Lightweight Directory Access Protocol") +
-					getFormattedValueAsTableRow("LDAPS", "Secure LDAP") +
-					getFormattedValueAsTableRow("TCP", "Transmission Control Protocol") +
-					getFormattedValueAsTableRow("TCPS", "Secure TCP"),
+					getFormattedValuesAsTable("protocol", "description", systemMappingProtocols),
 				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(getAllowedValues(systemMappingProtocols)...),
+				},
 			},
 			"backend_type": schema.StringAttribute{
 				MarkdownDescription: "Type of the backend system. Valid values are:" +
-					getFormattedValueAsTableRow("backend", "description") +
-					getFormattedValueAsTableRow("---", "---") +
-					getFormattedValueAsTableRow("abapSys", "ABAP-based SAP system") +
-					getFormattedValueAsTableRow("netweaverCE", "SAP NetWeaver Composition Environment") +
-					getFormattedValueAsTableRow("netweaverGW", "SAP NetWeaver Gateway") +
-					getFormattedValueAsTableRow("applServerJava", "Java-based application server") +
-					getFormattedValueAsTableRow("PI", "SAP Process Integration system") +
-					getFormattedValueAsTableRow("hana", "SAP HANA system") +
-					getFormattedValueAsTableRow("otherSAPsys", "Other SAP system") +
-					getFormattedValueAsTableRow("nonSAPsys", "Non-SAP system"),
+					getFormattedValuesAsTable("backend", "description", systemMappingBackendTypes),
 				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(getAllowedValues(systemMappingBackendTypes)...),
+				},
 			},
 			"authentication_mode": schema.StringAttribute{
 				MarkdownDescription: "Authentication mode to be used on the backend side, which must be one of the following:" +
-					getFormattedValueAsTableRow("authentication mode", "description") +
-					getFormattedValueAsTableRow("---", "---") +
-					getFormattedValueAsTableRow("NONE", "No authentication") +
-					getFormattedValueAsTableRow("NONE_RESTRICTED", "No authentication; system certificate will never be sent") +
-					getFormattedValueAsTableRow("X509_GENERAL", "X.509 certificate-based authentication, system certificate may be sent") +
-					getFormattedValueAsTableRow("X509_RESTRICTED", "X.509 certificate-based authentication, system certificate never sent") +
-					getFormattedValueAsTableRow("KERBEROS", "Kerberos-based authentication") +
+					getFormattedValuesAsTable("authentication mode", "description", systemMappingAuthenticationModes) +
 					"The authentication modes NONE_RESTRICTED and X509_RESTRICTED prevent the Cloud Connector from sending the system certificate in any case, whereas NONE and X509_GENERAL will send the system certificate if the circumstances allow it.",
 				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(getAllowedValues(systemMappingAuthenticationModes)...),
+				},
 			},
 			"host_in_header": schema.StringAttribute{
-				MarkdownDescription: "Policy for setting the host in the response header. This property is applicable to HTTP(S) protocols only. If set, it must be one of the following strings:" +
-					getFormattedValueAsTableRow("policy", "description") +
-					getFormattedValueAsTableRow("---", "---") +
-					getFormattedValueAsTableRow("internal/INTERNAL", "Use internal (local) host for HTTP headers") +
-					getFormattedValueAsTableRow("virtual/VIRTUAL", "Use virtual host (default) for HTTP headers") + "The default is virtual.",
-				Required: true,
+				MarkdownDescription: "Policy for setting the host in the response header. This property is applicable to HTTP(S) protocols only. For other protocols, a configured value has no effect and causes a warning, which will become an error in a future release. If set, it must be one of the following strings:" +
+					getFormattedValuesAsTable("policy", "description", systemMappingHostInHeaderPolicies) + "The default is virtual.",
+				Computed: true,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(getAllowedValues(systemMappingHostInHeaderPolicies)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"sid": schema.StringAttribute{
 				MarkdownDescription: "The ID of the system.",
@@ -155,6 +154,29 @@ __Further documentation:__
 	}
 }
 
+func (r *SystemMappingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"region_host": identityschema.StringAttribute{
+				Description:       "Region Host Name.",
+				RequiredForImport: true,
+			},
+			"subaccount": identityschema.StringAttribute{
+				Description:       "The ID of the subaccount.",
+				RequiredForImport: true,
+			},
+			"virtual_host": identityschema.StringAttribute{
+				Description:       "Virtual host used on the cloud side.",
+				RequiredForImport: true,
+			},
+			"virtual_port": identityschema.StringAttribute{
+				Description:       "Virtual port used on the cloud side.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *SystemMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -174,6 +196,39 @@ func (r *SystemMappingResource) Configure(ctx context.Context, req resource.Conf
 	r.client = client
 }
 
+func (r *SystemMappingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SystemMappingConfig
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The combinations can only be checked once the protocol is known
+	if config.Protocol.IsNull() || config.Protocol.IsUnknown() || isHTTPProtocol(config.Protocol.ValueString()) {
+		return
+	}
+
+	protocol := config.Protocol.ValueString()
+
+	// The attribute used to be required for all protocols, so existing configurations are only warned for now
+	if !config.HostInHeader.IsNull() && !config.HostInHeader.IsUnknown() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("host_in_header"),
+			"Attribute Not Applicable",
+			fmt.Sprintf("The attribute host_in_header only applies to the protocols HTTP and HTTPS, got protocol: %s. The value has no effect and will be rejected in a future release, remove it from the configuration.", protocol),
+		)
+	}
+
+	if config.AuthenticationMode.ValueString() == "KERBEROS" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("authentication_mode"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The authentication mode KERBEROS only applies to the protocols HTTP and HTTPS, got protocol: %s", protocol),
+		)
+	}
+}
+
 func (r *SystemMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan SystemMappingConfig
 	var respObj apiobjects.SystemMapping
@@ -197,11 +252,15 @@ func (r *SystemMappingResource) Create(ctx context.Context, req resource.CreateR
 		"protocol":           plan.Protocol.ValueString(),
 		"backendType":        plan.BackendType.ValueString(),
 		"authenticationMode": plan.AuthenticationMode.ValueString(),
-		"hostInHeader":       plan.HostInHeader.ValueString(),
 		"sid":                plan.Sid.ValueString(),
 		"description":        plan.Description.ValueString(),
 	}
 
+	// The Cloud Connector applies its default policy if none is given
+	if !plan.HostInHeader.IsNull() && !plan.HostInHeader.IsUnknown() {
+		planBody["hostInHeader"] = plan.HostInHeader.ValueString()
+	}
+
 	err := requestAndUnmarshal(r.client, &respObj, "POST", endpoint, planBody, false)
 	if err != nil {
 		resp.Diagnostics.AddError(errMsgAddSystemMappingFailed, err.Error())
@@ -216,6 +275,8 @@ func (r *SystemMappingResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	warnIfSNCNotConfigured(r.client, plan.Protocol.ValueString(), &resp.Diagnostics)
+
 	responseModel, err := SystemMappingValueFrom(ctx, plan, respObj)
 	if err != nil {
 		resp.Diagnostics.AddError(errMsgMapSystemMappingFailed, fmt.Sprintf("%s", err))
@@ -227,6 +288,8 @@ func (r *SystemMappingResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SystemMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -261,6 +324,8 @@ func (r *SystemMappingResource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SystemMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -284,9 +349,7 @@ func (r *SystemMappingResource) Update(ctx context.Context, req resource.UpdateR
 	virtualPort := plan.VirtualPort.ValueString()
 
 	if (regionHost != state.RegionHost.ValueString()) ||
-		(subaccount != state.Subaccount.ValueString()) ||
-		(virtualHost != state.VirtualHost.ValueString()) ||
-		(virtualPort != state.VirtualPort.ValueString()) {
+		(subaccount != state.Subaccount.ValueString()) {
 		resp.Diagnostics.AddError(errMsgUpdateSystemMappingFailed, "Failed to update the cloud connector system mapping due to mismatched configuration values.")
 		return
 	}
@@ -300,11 +363,15 @@ func (r *SystemMappingResource) Update(ctx context.Context, req resource.UpdateR
 		"protocol":           plan.Protocol.ValueString(),
 		"backendType":        plan.BackendType.ValueString(),
 		"authenticationMode": plan.AuthenticationMode.ValueString(),
-		"hostInHeader":       plan.HostInHeader.ValueString(),
 		"sid":                plan.Sid.ValueString(),
 		"description":        plan.Description.ValueString(),
 	}
 
+	// The Cloud Connector applies its default policy if none is given
+	if !plan.HostInHeader.IsNull() && !plan.HostInHeader.IsUnknown() {
+		planBody["hostInHeader"] = plan.HostInHeader.ValueString()
+	}
+
 	err := requestAndUnmarshal(r.client, &respObj, "PUT", endpoint, planBody, false)
 	if err != nil {
 		resp.Diagnostics.AddError(errMsgUpdateSystemMappingFailed, err.Error())
@@ -317,6 +384,8 @@ func (r *SystemMappingResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	warnIfSNCNotConfigured(r.client, plan.Protocol.ValueString(), &resp.Diagnostics)
+
 	responseModel, err := SystemMappingValueFrom(ctx, plan, respObj)
 	if err != nil {
 		resp.Diagnostics.AddError(errMsgMapSystemMappingFailed, fmt.Sprintf("%s", err))
@@ -328,6 +397,8 @@ func (r *SystemMappingResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SystemMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -365,6 +436,10 @@ func (r *SystemMappingResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (rs *SystemMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if importStateFromIdentity(ctx, req, resp) {
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
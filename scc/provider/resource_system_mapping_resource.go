@@ -2,20 +2,32 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
 	"strings"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
 	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var _ resource.Resource = &SystemMappingResourceResource{}
+var _ resource.ResourceWithIdentity = &SystemMappingResourceResource{}
+var _ resource.ResourceWithModifyPlan = &SystemMappingResourceResource{}
+
+// rfcNamespacedFunctionName matches RFC function names in a namespace, e.g. /NAMESPACE/FUNCTION.
+var rfcNamespacedFunctionName = regexp.MustCompile(`^/[A-Z0-9_]+/[A-Z0-9_]+$`)
 
 func NewSystemMappingResourceResource() resource.Resource {
 	return &SystemMappingResourceResource{}
@@ -53,15 +65,21 @@ __Further documentation:__
 				},
 			},
 			"virtual_host": schema.StringAttribute{
-				MarkdownDescription: "Virtual host used on the cloud side.",
+				MarkdownDescription: "Virtual host used on the cloud side. Changing the virtual host recreates the resource in the system mapping with the new virtual host.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"virtual_port": schema.StringAttribute{
-				MarkdownDescription: "Virtual port used on the cloud side.",
+				MarkdownDescription: "Virtual port used on the cloud side. Changing the virtual port recreates the resource in the system mapping with the new virtual port.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"url_path": schema.StringAttribute{
-				MarkdownDescription: "The resource itself, which, depending on the owning system mapping, is either a URL path (or the leading section of it), or a RFC function name.",
+				MarkdownDescription: "The resource itself, which, depending on the owning system mapping, is either a URL path (or the leading section of it), or a RFC function name. For system mappings with the protocols RFC and RFCS, a warning is shown if the function name contains a slash but is not in a namespace, e.g. `/NAMESPACE/FUNCTION`.",
 				Required:            true,
 			},
 			"enabled": schema.BoolAttribute{
@@ -80,7 +98,7 @@ __UI Equivalent:__ *Access Policy*
 				Optional: true,
 			},
 			"websocket_upgrade_allowed": schema.BoolAttribute{
-				MarkdownDescription: "Boolean flag indicating whether websocket upgrade is allowed. This property is of relevance only if the owning system mapping employs protocol HTTP or HTTPS.",
+				MarkdownDescription: "Boolean flag indicating whether websocket upgrade is allowed. This property is of relevance only if the owning system mapping employs protocol HTTP or HTTPS. Enabling it for a system mapping with another protocol is rejected during planning.",
 				Computed:            true,
 				Optional:            true,
 			},
@@ -98,6 +116,33 @@ __UI Equivalent:__ *Access Policy*
 	}
 }
 
+func (r *SystemMappingResourceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"region_host": identityschema.StringAttribute{
+				Description:       "Region Host Name.",
+				RequiredForImport: true,
+			},
+			"subaccount": identityschema.StringAttribute{
+				Description:       "The ID of the subaccount.",
+				RequiredForImport: true,
+			},
+			"virtual_host": identityschema.StringAttribute{
+				Description:       "Virtual host used on the cloud side.",
+				RequiredForImport: true,
+			},
+			"virtual_port": identityschema.StringAttribute{
+				Description:       "Virtual port used on the cloud side.",
+				RequiredForImport: true,
+			},
+			"url_path": identityschema.StringAttribute{
+				Description:       "The resource itself, which, depending on the owning system mapping, is either a URL path (or the leading section of it), or a RFC function name.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *SystemMappingResourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -117,6 +162,67 @@ func (r *SystemMappingResourceResource) Configure(ctx context.Context, req resou
 	r.client = client
 }
 
+func (r *SystemMappingResourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destroying a resource must always be possible
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var config SystemMappingResourceConfig
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The owning system mapping can only be looked up once it is known
+	if config.RegionHost.IsUnknown() || config.Subaccount.IsUnknown() || config.VirtualHost.IsUnknown() || config.VirtualPort.IsUnknown() {
+		return
+	}
+
+	var mapping apiobjects.SystemMapping
+	endpoint := endpoints.GetSystemMappingEndpoint(config.RegionHost.ValueString(), config.Subaccount.ValueString(), config.VirtualHost.ValueString(), config.VirtualPort.ValueString())
+
+	// The system mapping may be created in the same apply, a missing system mapping is reported when the resource is created
+	if err := requestAndUnmarshal(r.client, &mapping, "GET", endpoint, nil, true); err != nil {
+		var responseErr *api.ResponseError
+		if !errors.As(err, &responseErr) || responseErr.StatusCode != http.StatusNotFound {
+			resp.Diagnostics.AddWarning(warnMsgSystemMappingNotChecked, fmt.Sprintf("The resource could not be checked against the protocol of the owning system mapping: %s", err))
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(validateSystemMappingResourceProtocol(mapping.Protocol, config)...)
+}
+
+// validateSystemMappingResourceProtocol checks the configuration of a system mapping resource against the protocol of the owning system mapping.
+func validateSystemMappingResourceProtocol(protocol string, config SystemMappingResourceConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if isHTTPProtocol(protocol) {
+		return diags
+	}
+
+	if config.WebsocketUpgradeAllowed.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("websocket_upgrade_allowed"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("WebSocket upgrade is only supported for system mappings with the protocols HTTP and HTTPS, the owning system mapping uses protocol: %s", protocol),
+		)
+	}
+
+	urlPath := config.URLPath.ValueString()
+	if (protocol == "RFC" || protocol == "RFCS") && strings.Contains(urlPath, "/") && !rfcNamespacedFunctionName.MatchString(urlPath) {
+		diags.AddAttributeWarning(
+			path.Root("url_path"),
+			"Unexpected RFC Function Name",
+			fmt.Sprintf("The owning system mapping uses protocol %s, so url_path is the name of an RFC function and not a URL path. Only function names in a namespace, e.g. /NAMESPACE/FUNCTION, contain slashes. Got: %s", protocol, urlPath),
+		)
+	}
+
+	return diags
+}
+
 func (r *SystemMappingResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan SystemMappingResourceConfig
 	var respObj apiobjects.SystemMappingResource
@@ -166,6 +272,8 @@ func (r *SystemMappingResourceResource) Create(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SystemMappingResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -201,6 +309,8 @@ func (r *SystemMappingResourceResource) Read(ctx context.Context, req resource.R
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SystemMappingResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -225,9 +335,7 @@ func (r *SystemMappingResourceResource) Update(ctx context.Context, req resource
 	resourceID := CreateEncodedResourceID(plan.URLPath.ValueString())
 
 	if (state.RegionHost.ValueString() != regionHost) ||
-		(state.Subaccount.ValueString() != subaccount) ||
-		(state.VirtualHost.ValueString() != virtualHost) ||
-		(state.VirtualPort.ValueString() != virtualPort) {
+		(state.Subaccount.ValueString() != subaccount) {
 		resp.Diagnostics.AddError(errMsgUpdateSystemMappingResourceFailed, "Failed to update the cloud connector system mapping resource due to mismatched configuration values.")
 		return
 	}
@@ -265,6 +373,8 @@ func (r *SystemMappingResourceResource) Update(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SystemMappingResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -303,6 +413,10 @@ func (r *SystemMappingResourceResource) Delete(ctx context.Context, req resource
 }
 
 func (rs *SystemMappingResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if importStateFromIdentity(ctx, req, resp) {
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 
 	if len(idParts) != 5 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" || idParts[4] == "" {
@@ -5,8 +5,13 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestResourceSystemMappingResource(t *testing.T) {
@@ -64,6 +69,52 @@ func TestResourceSystemMappingResource(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - import by identity", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_system_mapping_resource_import_identity")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_12_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + importBlockWithIdentity("scc_system_mapping_resource.test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\tsubaccount = \"9f7390c8-f201-4b2d-b751-04c0a63c2671\"\n\tvirtual_host = \"testterraformvirtual\"\n\tvirtual_port = \"900\"\n\turl_path = \"/\"") + ResourceSystemMappingResource("test", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "testterraformvirtual", "900", "/", "", true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_system_mapping_resource.test", "enabled", "true"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("scc_system_mapping_resource.test", map[string]knownvalue.Check{
+							"region_host":  knownvalue.StringExact("cf.eu12.hana.ondemand.com"),
+							"subaccount":   knownvalue.StringExact("9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+							"virtual_host": knownvalue.StringExact("testterraformvirtual"),
+							"virtual_port": knownvalue.StringExact("900"),
+							"url_path":     knownvalue.StringExact("/"),
+						}),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - websocket upgrade for RFC system mapping", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_system_mapping_resource_err_websocket_rfc")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config:      providerConfig(user) + ResourceSystemMappingResourceWithWebsocket("test", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtualrfc", "3300", "BAPI_USER_GET_DETAIL", true),
+					ExpectError: regexp.MustCompile(`(?is)WebSocket upgrade is only supported for system mappings with the protocols.*HTTP and HTTPS, the owning system mapping uses protocol: RFC`),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - region host mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
@@ -131,6 +182,39 @@ func TestResourceSystemMappingResource(t *testing.T) {
 
 }
 
+func TestValidateSystemMappingResourceProtocol(t *testing.T) {
+	tests := []struct {
+		description string
+		protocol    string
+		urlPath     string
+		websocket   bool
+		errors      int
+		warnings    int
+	}{
+		{description: "happy path - websocket upgrade for HTTPS", protocol: "HTTPS", urlPath: "/api", websocket: true},
+		{description: "happy path - RFC function name", protocol: "RFC", urlPath: "BAPI_USER_GET_DETAIL"},
+		{description: "happy path - RFC function name in a namespace", protocol: "RFCS", urlPath: "/BODS/RFC_READ_TABLE"},
+		{description: "error path - websocket upgrade for TCP", protocol: "TCP", urlPath: "/", websocket: true, errors: 1},
+		{description: "error path - URL path for RFC", protocol: "RFC", urlPath: "/sap/bc/rest/", warnings: 1},
+		{description: "error path - short URL path for RFC", protocol: "RFC", urlPath: "/sap/bc", warnings: 1},
+		{description: "error path - RFC namespace without function name", protocol: "RFCS", urlPath: "/BODS/", warnings: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			config := SystemMappingResourceConfig{
+				URLPath:                 types.StringValue(test.urlPath),
+				WebsocketUpgradeAllowed: types.BoolValue(test.websocket),
+			}
+
+			diags := validateSystemMappingResourceProtocol(test.protocol, config)
+
+			assert.Equal(t, test.errors, diags.ErrorsCount())
+			assert.Equal(t, test.warnings, diags.WarningsCount())
+		})
+	}
+}
+
 func ResourceSystemMappingResource(datasourceName string, regionHost string, subaccount string, virtualHost string, virtualPort string,
 	urlPath string, description string, enabled bool) string {
 	return fmt.Sprintf(`
@@ -146,6 +230,20 @@ func ResourceSystemMappingResource(datasourceName string, regionHost string, sub
 	`, datasourceName, regionHost, subaccount, virtualHost, virtualPort, urlPath, description, enabled)
 }
 
+func ResourceSystemMappingResourceWithWebsocket(datasourceName string, regionHost string, subaccount string, virtualHost string, virtualPort string,
+	urlPath string, websocketUpgradeAllowed bool) string {
+	return fmt.Sprintf(`
+	resource "scc_system_mapping_resource" "%s" {
+	region_host = "%s"
+	subaccount = "%s"
+	virtual_host = "%s"
+	virtual_port = "%s"
+	url_path = "%s"
+	websocket_upgrade_allowed = %t
+	}
+	`, datasourceName, regionHost, subaccount, virtualHost, virtualPort, urlPath, websocketUpgradeAllowed)
+}
+
 func ResourceSystemMappingResourceWoRegionHost(datasourceName string, subaccount string, virtualHost string, virtualPort string,
 	urlPath string, description string, enabled bool) string {
 	return fmt.Sprintf(`
@@ -6,7 +6,12 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestResourceSystemMapping(t *testing.T) {
@@ -79,6 +84,112 @@ func TestResourceSystemMapping(t *testing.T) {
 		})
 	})
 
+	t.Run("update path - virtual host change replaces system mapping", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_system_mapping_replace")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceSystemMapping("test", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtualrename", "900", "testtfinternal", "900", "HTTP", "abapSys", "VIRTUAL", "NONE") +
+						ResourceSystemMappingResourceOfMapping("test", "scc_system_mapping.test", "/api"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_system_mapping.test", "virtual_host", "testtfvirtualrename"),
+						resource.TestCheckResourceAttr("scc_system_mapping_resource.test", "virtual_host", "testtfvirtualrename"),
+					),
+				},
+				{
+					Config: providerConfig(user) + ResourceSystemMapping("test", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtualrenamed", "901", "testtfinternal", "900", "HTTP", "abapSys", "VIRTUAL", "NONE") +
+						ResourceSystemMappingResourceOfMapping("test", "scc_system_mapping.test", "/api"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_system_mapping.test", plancheck.ResourceActionReplace),
+							// Resources that reference the system mapping are recreated in the replacement
+							plancheck.ExpectResourceAction("scc_system_mapping_resource.test", plancheck.ResourceActionReplace),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_system_mapping.test", "virtual_host", "testtfvirtualrenamed"),
+						resource.TestCheckResourceAttr("scc_system_mapping.test", "virtual_port", "901"),
+						resource.TestCheckResourceAttr("scc_system_mapping_resource.test", "virtual_host", "testtfvirtualrenamed"),
+						resource.TestCheckResourceAttr("scc_system_mapping_resource.test", "virtual_port", "901"),
+						resource.TestCheckResourceAttr("scc_system_mapping_resource.test", "url_path", "/api"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - host in header not configured", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_system_mapping_wo_host_in_header")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) +
+						ResourceSystemMappingWoHostInHeader("rfc", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtualrfcdefault", "3301", "testtfinternal", "3301", "RFC", "abapSys", "NONE") +
+						ResourceSystemMappingWoHostInHeader("http", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtualhttpdefault", "901", "testtfinternal", "901", "HTTP", "abapSys", "NONE"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_system_mapping.rfc", "protocol", "RFC"),
+						resource.TestCheckResourceAttr("scc_system_mapping.rfc", "host_in_header", ""),
+						resource.TestCheckResourceAttr("scc_system_mapping.http", "protocol", "HTTP"),
+						resource.TestCheckResourceAttr("scc_system_mapping.http", "host_in_header", "VIRTUAL"),
+					),
+				},
+				{
+					Config: providerConfig(user) +
+						ResourceSystemMappingWoHostInHeader("rfc", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtualrfcdefault", "3301", "testtfinternal", "3301", "RFC", "abapSys", "NONE") +
+						ResourceSystemMappingWoHostInHeader("http", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtualhttpdefault", "901", "updatedlocal", "902", "HTTP", "abapSys", "NONE"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_system_mapping.http", plancheck.ResourceActionUpdate),
+							plancheck.ExpectKnownValue("scc_system_mapping.http", tfjsonpath.New("host_in_header"), knownvalue.StringExact("VIRTUAL")),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_system_mapping.http", "internal_host", "updatedlocal"),
+						resource.TestCheckResourceAttr("scc_system_mapping.http", "internal_port", "902"),
+						resource.TestCheckResourceAttr("scc_system_mapping.http", "host_in_header", "VIRTUAL"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - import by identity", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_system_mapping_import_identity")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_12_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + importBlockWithIdentity("scc_system_mapping.test", "region_host = \"cf.eu12.hana.ondemand.com\"\n\tsubaccount = \"9f7390c8-f201-4b2d-b751-04c0a63c2671\"\n\tvirtual_host = \"testterraformvirtual\"\n\tvirtual_port = \"900\"") + ResourceSystemMapping("test", "cf.eu12.hana.ondemand.com", "9f7390c8-f201-4b2d-b751-04c0a63c2671", "testterraformvirtual", "900", "testterraforminternal", "900", "HTTP", "abapSys", "VIRTUAL", "KERBEROS"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_system_mapping.test", "internal_host", "testterraforminternal"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("scc_system_mapping.test", map[string]knownvalue.Check{
+							"region_host":  knownvalue.StringExact("cf.eu12.hana.ondemand.com"),
+							"subaccount":   knownvalue.StringExact("9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+							"virtual_host": knownvalue.StringExact("testterraformvirtual"),
+							"virtual_port": knownvalue.StringExact("900"),
+						}),
+					},
+				},
+			},
+		})
+	})
+
 	t.Run("error path - region host mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
@@ -183,14 +294,86 @@ func TestResourceSystemMapping(t *testing.T) {
 		})
 	})
 
-	t.Run("error path - host in header mandatory", func(t *testing.T) {
+	t.Run("error path - invalid protocol", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSystemMapping("test", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtual", "900", "testtfinternal", "900", "FTP", "abapSys", "VIRTUAL", "NONE"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute protocol value must be one of:.*"HTTPS"`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid backend type", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSystemMapping("test", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtual", "900", "testtfinternal", "900", "HTTP", "abapSystem", "VIRTUAL", "NONE"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute backend_type value must be one of:.*"abapSys"`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid host in header", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSystemMapping("test", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtual", "900", "testtfinternal", "900", "HTTP", "abapSys", "LOCAL", "NONE"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute host_in_header value must be one of:.*"VIRTUAL"`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid authentication mode", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
 			ProtoV6ProviderFactories: getTestProviders(nil),
 			Steps: []resource.TestStep{
 				{
-					Config:      ResourceSystemMappingWoHostInHeader("test", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtual", "900", "testtfinternal", "900", "HTTP", "abapSys", "KERBEROS"),
-					ExpectError: regexp.MustCompile(`The argument "host_in_header" is required, but no definition was found.`),
+					Config:      ResourceSystemMapping("test", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtual", "900", "testtfinternal", "900", "HTTP", "abapSys", "VIRTUAL", "BASIC"),
+					ExpectError: regexp.MustCompile(`(?is)Attribute authentication_mode value must be one of:.*"KERBEROS"`),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - host in header for RFC", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_system_mapping_rfc_host_in_header")
+		defer stopQuietly(rec)
+
+		// Configurations that set host_in_header for other protocols than HTTP(S) only cause a warning
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(user) + ResourceSystemMapping("test", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtualrfcheader", "3301", "testtfinternal", "3301", "RFC", "abapSys", "VIRTUAL", "NONE"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_system_mapping.test", "protocol", "RFC"),
+						resource.TestCheckResourceAttr("scc_system_mapping.test", "host_in_header", "VIRTUAL"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - kerberos only for HTTP(S)", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSystemMappingWoHostInHeader("test", "cf.eu12.hana.ondemand.com", "d3bbbcd7-d5e0-483b-a524-6dee7205f8e8", "testtfvirtual", "900", "testtfinternal", "900", "RFC", "abapSys", "KERBEROS"),
+					ExpectError: regexp.MustCompile(`(?is)The authentication mode KERBEROS only applies to the protocols HTTP and.*HTTPS, got protocol: RFC`),
 				},
 			},
 		})
@@ -229,6 +412,18 @@ func ResourceSystemMapping(datasourceName string, regionHost string, subaccount
 	`, datasourceName, regionHost, subaccount, virtualHost, virtualPort, internalHost, internalPort, protocol, backendType, hostInHeader, authenticationMode)
 }
 
+func ResourceSystemMappingResourceOfMapping(datasourceName string, systemMapping string, urlPath string) string {
+	return fmt.Sprintf(`
+	resource "scc_system_mapping_resource" "%[1]s" {
+	region_host = %[2]s.region_host
+	subaccount = %[2]s.subaccount
+	virtual_host = %[2]s.virtual_host
+	virtual_port = %[2]s.virtual_port
+	url_path = "%[3]s"
+	}
+	`, datasourceName, systemMapping, urlPath)
+}
+
 func ResourceSystemMappingWoRegionHost(datasourceName string, subaccount string, virtualHost string, virtualPort string,
 	internalHost string, internalPort string, protocol string, backendType string, hostInHeader string, authenticationMode string) string {
 	return fmt.Sprintf(`
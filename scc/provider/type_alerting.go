@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AlertingEmailConfig struct {
+	SMTPHost              types.String `tfsdk:"smtp_host"`
+	SMTPPort              types.Int64  `tfsdk:"smtp_port"`
+	SMTPUser              types.String `tfsdk:"smtp_user"`
+	SMTPPasswordWO        types.String `tfsdk:"smtp_password_wo"`
+	SMTPPasswordWOVersion types.Int64  `tfsdk:"smtp_password_wo_version"`
+	Sender                types.String `tfsdk:"sender"`
+	Recipients            types.List   `tfsdk:"recipients"`
+	TLSEnabled            types.Bool   `tfsdk:"tls_enabled"`
+	SendTestEmail         types.Bool   `tfsdk:"send_test_email"`
+}
+
+type AlertingSettingsConfig struct {
+	AlertTypes types.Set `tfsdk:"alert_types"`
+}
+
+func AlertingEmailValueFrom(ctx context.Context, plan AlertingEmailConfig, value apiobjects.AlertingEmail) (AlertingEmailConfig, diag.Diagnostics) {
+	recipients, diags := types.ListValueFrom(ctx, types.StringType, value.Recipients)
+	if diags.HasError() {
+		return AlertingEmailConfig{}, diags
+	}
+
+	model := &AlertingEmailConfig{
+		SMTPHost:              types.StringValue(value.SMTPHost),
+		SMTPPort:              types.Int64Value(value.SMTPPort),
+		SMTPUser:              types.StringValue(value.SMTPUser),
+		SMTPPasswordWO:        types.StringNull(),
+		SMTPPasswordWOVersion: plan.SMTPPasswordWOVersion,
+		Sender:                types.StringValue(value.Sender),
+		Recipients:            recipients,
+		TLSEnabled:            types.BoolValue(value.TLSEnabled),
+		SendTestEmail:         plan.SendTestEmail,
+	}
+
+	return *model, diags
+}
+
+func AlertingSettingsValueFrom(ctx context.Context, value apiobjects.AlertingSettings) (AlertingSettingsConfig, diag.Diagnostics) {
+	alertTypes, diags := types.SetValueFrom(ctx, types.StringType, value.AlertTypes)
+	if diags.HasError() {
+		return AlertingSettingsConfig{}, diags
+	}
+
+	model := &AlertingSettingsConfig{
+		AlertTypes: alertTypes,
+	}
+
+	return *model, diags
+}
+
+type AlertMessage struct {
+	ID           types.String `tfsdk:"id"`
+	Type         types.String `tfsdk:"type"`
+	Severity     types.String `tfsdk:"severity"`
+	Timestamp    types.Int64  `tfsdk:"timestamp"`
+	RegionHost   types.String `tfsdk:"region_host"`
+	Subaccount   types.String `tfsdk:"subaccount"`
+	Message      types.String `tfsdk:"message"`
+	Acknowledged types.Bool   `tfsdk:"acknowledged"`
+}
+
+type AlertMessagesConfig struct {
+	Severity      types.String   `tfsdk:"severity"`
+	AlertMessages []AlertMessage `tfsdk:"alert_messages"`
+}
+
+type AlertMessagesAcknowledgeConfig struct {
+	IDs       types.Set    `tfsdk:"ids"`
+	Operation types.String `tfsdk:"operation"`
+}
+
+func AlertMessagesValueFrom(ctx context.Context, plan AlertMessagesConfig, value apiobjects.AlertMessages) (AlertMessagesConfig, error) {
+	alertMessages := []AlertMessage{}
+	for _, message := range value.AlertMessages {
+		if !plan.Severity.IsNull() && message.Severity != plan.Severity.ValueString() {
+			continue
+		}
+
+		c := AlertMessage{
+			ID:           types.StringValue(message.ID),
+			Type:         types.StringValue(message.Type),
+			Severity:     types.StringValue(message.Severity),
+			Timestamp:    types.Int64Value(message.Timestamp),
+			RegionHost:   types.StringValue(message.RegionHost),
+			Subaccount:   types.StringValue(message.Subaccount),
+			Message:      types.StringValue(message.Message),
+			Acknowledged: types.BoolValue(message.Acknowledged),
+		}
+		alertMessages = append(alertMessages, c)
+	}
+
+	model := &AlertMessagesConfig{
+		Severity:      plan.Severity,
+		AlertMessages: alertMessages,
+	}
+
+	return *model, nil
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectorConfigurationConfig struct {
+	ConnectionTimeout           types.Int64 `tfsdk:"connection_timeout"`
+	MinThreadPoolSize           types.Int64 `tfsdk:"min_thread_pool_size"`
+	MaxThreadPoolSize           types.Int64 `tfsdk:"max_thread_pool_size"`
+	MaxPayloadSize              types.Int64 `tfsdk:"max_payload_size"`
+	AllowHTTPWebSocketCloud     types.Bool  `tfsdk:"allow_http_websocket_cloud"`
+	AllowHTTPWebSocketOnPremise types.Bool  `tfsdk:"allow_http_websocket_on_premise"`
+	DisableUI                   types.Bool  `tfsdk:"disable_ui"`
+}
+
+func ConnectorConfigurationValueFrom(ctx context.Context, value apiobjects.ConnectorConfiguration) (ConnectorConfigurationConfig, diag.Diagnostics) {
+	model := &ConnectorConfigurationConfig{
+		ConnectionTimeout:           types.Int64Value(value.ConnectionTimeout),
+		MinThreadPoolSize:           types.Int64Value(value.MinThreadPoolSize),
+		MaxThreadPoolSize:           types.Int64Value(value.MaxThreadPoolSize),
+		MaxPayloadSize:              types.Int64Value(value.MaxPayloadSize),
+		AllowHTTPWebSocketCloud:     types.BoolValue(value.AllowHTTPWebSocketCloud),
+		AllowHTTPWebSocketOnPremise: types.BoolValue(value.AllowHTTPWebSocketOnPremise),
+		DisableUI:                   types.BoolValue(value.UIDisabled),
+	}
+
+	return *model, diag.Diagnostics{}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectorVersionConfig struct {
+	Version types.String `tfsdk:"version"`
+	Major   types.Int64  `tfsdk:"major"`
+	Minor   types.Int64  `tfsdk:"minor"`
+	Patch   types.Int64  `tfsdk:"patch"`
+}
+
+func ConnectorVersionValueFrom(ctx context.Context, value apiobjects.ConnectorVersion) (ConnectorVersionConfig, error) {
+	version, err := api.ParseConnectorVersion(value.Version)
+	if err != nil {
+		return ConnectorVersionConfig{}, err
+	}
+
+	model := &ConnectorVersionConfig{
+		Version: types.StringValue(value.Version),
+		Major:   types.Int64Value(version.Major),
+		Minor:   types.Int64Value(version.Minor),
+		Patch:   types.Int64Value(version.Patch),
+	}
+
+	return *model, nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type KerberosSettingsConfig struct {
+	Realms          types.List   `tfsdk:"realms"`
+	ServiceUser     types.String `tfsdk:"service_user"`
+	KeytabWO        types.String `tfsdk:"keytab_wo"`
+	KeytabWOVersion types.Int64  `tfsdk:"keytab_wo_version"`
+}
+
+type KerberosRealmData struct {
+	Name     types.String `tfsdk:"name"`
+	KDCHosts types.List   `tfsdk:"kdc_hosts"`
+}
+
+var KerberosRealmType = map[string]attr.Type{
+	"name":      types.StringType,
+	"kdc_hosts": types.ListType{ElemType: types.StringType},
+}
+
+func KerberosSettingsValueFrom(ctx context.Context, plan KerberosSettingsConfig, value apiobjects.KerberosSettings) (KerberosSettingsConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	realmsData := []KerberosRealmData{}
+	for _, realm := range value.Realms {
+		kdcHosts, err := types.ListValueFrom(ctx, types.StringType, realm.KDCs)
+		diags.Append(err...)
+
+		realmsData = append(realmsData, KerberosRealmData{
+			Name:     types.StringValue(realm.Name),
+			KDCHosts: kdcHosts,
+		})
+	}
+
+	realms, err := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: KerberosRealmType}, realmsData)
+	diags.Append(err...)
+
+	if diags.HasError() {
+		return KerberosSettingsConfig{}, diags
+	}
+
+	model := &KerberosSettingsConfig{
+		Realms:          realms,
+		ServiceUser:     types.StringValue(value.ServiceUser),
+		KeytabWO:        types.StringNull(),
+		KeytabWOVersion: plan.KeytabWOVersion,
+	}
+
+	return *model, diags
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type LDAPAuthenticationConfig struct {
+	Hosts                       types.List   `tfsdk:"hosts"`
+	SecondaryHosts              types.List   `tfsdk:"secondary_hosts"`
+	UserBaseDN                  types.String `tfsdk:"user_base_dn"`
+	GroupBaseDN                 types.String `tfsdk:"group_base_dn"`
+	ConnectionUser              types.String `tfsdk:"connection_user"`
+	ConnectionPasswordWO        types.String `tfsdk:"connection_password_wo"`
+	ConnectionPasswordWOVersion types.Int64  `tfsdk:"connection_password_wo_version"`
+	RoleMapping                 types.Object `tfsdk:"role_mapping"`
+	TestConnection              types.Bool   `tfsdk:"test_connection"`
+}
+
+type LDAPHostData struct {
+	Host       types.String `tfsdk:"host"`
+	Port       types.Int64  `tfsdk:"port"`
+	TLSEnabled types.Bool   `tfsdk:"tls_enabled"`
+}
+
+var LDAPHostType = map[string]attr.Type{
+	"host":        types.StringType,
+	"port":        types.Int64Type,
+	"tls_enabled": types.BoolType,
+}
+
+type LDAPRoleMappingData struct {
+	Administrator           types.String `tfsdk:"administrator"`
+	Display                 types.String `tfsdk:"display"`
+	Support                 types.String `tfsdk:"support"`
+	SubaccountAdministrator types.String `tfsdk:"subaccount_administrator"`
+}
+
+var LDAPRoleMappingType = map[string]attr.Type{
+	"administrator":            types.StringType,
+	"display":                  types.StringType,
+	"support":                  types.StringType,
+	"subaccount_administrator": types.StringType,
+}
+
+func ldapHostsValueFrom(ctx context.Context, value []apiobjects.LDAPHost) (types.List, diag.Diagnostics) {
+	hosts := []LDAPHostData{}
+	for _, host := range value {
+		hosts = append(hosts, LDAPHostData{
+			Host:       types.StringValue(host.Host),
+			Port:       types.Int64Value(host.Port),
+			TLSEnabled: types.BoolValue(host.TLSEnabled),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: LDAPHostType}, hosts)
+}
+
+func LDAPAuthenticationValueFrom(ctx context.Context, plan LDAPAuthenticationConfig, value apiobjects.LDAPAuthentication) (LDAPAuthenticationConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hosts, err := ldapHostsValueFrom(ctx, value.Hosts)
+	diags.Append(err...)
+
+	secondaryHosts, err := ldapHostsValueFrom(ctx, value.SecondaryHosts)
+	diags.Append(err...)
+
+	roleMappingObj := LDAPRoleMappingData{
+		Administrator:           types.StringValue(value.Roles.Administrator),
+		Display:                 types.StringValue(value.Roles.Display),
+		Support:                 types.StringValue(value.Roles.Support),
+		SubaccountAdministrator: types.StringValue(value.Roles.SubaccountAdministrator),
+	}
+
+	roleMapping, err := types.ObjectValueFrom(ctx, LDAPRoleMappingType, roleMappingObj)
+	diags.Append(err...)
+
+	if diags.HasError() {
+		return LDAPAuthenticationConfig{}, diags
+	}
+
+	model := &LDAPAuthenticationConfig{
+		Hosts:                       hosts,
+		SecondaryHosts:              secondaryHosts,
+		UserBaseDN:                  types.StringValue(value.UserBaseDN),
+		GroupBaseDN:                 types.StringValue(value.GroupBaseDN),
+		ConnectionUser:              types.StringValue(value.ConnectionUser),
+		ConnectionPasswordWO:        types.StringNull(),
+		ConnectionPasswordWOVersion: plan.ConnectionPasswordWOVersion,
+		RoleMapping:                 roleMapping,
+		TestConnection:              plan.TestConnection,
+	}
+
+	return *model, diags
+}
@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type LocalUserConfig struct {
+	UserName          types.String `tfsdk:"user_name"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func LocalUserValueFrom(ctx context.Context, plan LocalUserConfig, value apiobjects.LocalUser) (LocalUserConfig, diag.Diagnostics) {
+	model := &LocalUserConfig{
+		UserName:          types.StringValue(value.User),
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: plan.PasswordWOVersion,
+	}
+
+	return *model, diag.Diagnostics{}
+}
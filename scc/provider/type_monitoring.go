@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MonitoringHardwareConfig struct {
+	CPU      types.Object `tfsdk:"cpu"`
+	Memory   types.Object `tfsdk:"memory"`
+	Disk     types.Object `tfsdk:"disk"`
+	JavaHeap types.Object `tfsdk:"java_heap"`
+}
+
+type MonitoringCPUData struct {
+	Usage types.Float64 `tfsdk:"usage"`
+	Cores types.Int64   `tfsdk:"cores"`
+}
+
+var MonitoringCPUType = map[string]attr.Type{
+	"usage": types.Float64Type,
+	"cores": types.Int64Type,
+}
+
+type MonitoringCapacityData struct {
+	Total types.Int64 `tfsdk:"total"`
+	Used  types.Int64 `tfsdk:"used"`
+	Free  types.Int64 `tfsdk:"free"`
+}
+
+var MonitoringCapacityType = map[string]attr.Type{
+	"total": types.Int64Type,
+	"used":  types.Int64Type,
+	"free":  types.Int64Type,
+}
+
+type MonitoringPerformanceConfig struct {
+	RegionHost     types.String                   `tfsdk:"region_host"`
+	Subaccount     types.String                   `tfsdk:"subaccount"`
+	SinceTimeStamp types.Int64                    `tfsdk:"since_time_stamp"`
+	Backends       []MonitoringPerformanceBackend `tfsdk:"backends"`
+}
+
+type MonitoringPerformanceBackend struct {
+	VirtualHost     types.String `tfsdk:"virtual_host"`
+	VirtualPort     types.String `tfsdk:"virtual_port"`
+	Protocol        types.String `tfsdk:"protocol"`
+	Requests        types.Int64  `tfsdk:"requests"`
+	AverageDuration types.Int64  `tfsdk:"average_duration"`
+	MaximumDuration types.Int64  `tfsdk:"maximum_duration"`
+}
+
+type MonitoringTopTimeConsumersConfig struct {
+	RegionHost       types.String                `tfsdk:"region_host"`
+	Subaccount       types.String                `tfsdk:"subaccount"`
+	TopTimeConsumers []MonitoringTopTimeConsumer `tfsdk:"top_time_consumers"`
+}
+
+type MonitoringTopTimeConsumer struct {
+	TimeStamp       types.Int64  `tfsdk:"time_stamp"`
+	VirtualHost     types.String `tfsdk:"virtual_host"`
+	VirtualPort     types.String `tfsdk:"virtual_port"`
+	Protocol        types.String `tfsdk:"protocol"`
+	Resource        types.String `tfsdk:"resource"`
+	User            types.String `tfsdk:"user"`
+	TotalDuration   types.Int64  `tfsdk:"total_duration"`
+	BackendDuration types.Int64  `tfsdk:"backend_duration"`
+	SentBytes       types.Int64  `tfsdk:"sent_bytes"`
+	ReceivedBytes   types.Int64  `tfsdk:"received_bytes"`
+}
+
+func monitoringCapacityValueFrom(ctx context.Context, value apiobjects.MonitoringCapacity) (types.Object, diag.Diagnostics) {
+	capacityObj := MonitoringCapacityData{
+		Total: types.Int64Value(value.Total),
+		Used:  types.Int64Value(value.Used),
+		Free:  types.Int64Value(value.Free),
+	}
+
+	return types.ObjectValueFrom(ctx, MonitoringCapacityType, capacityObj)
+}
+
+func MonitoringHardwareValueFrom(ctx context.Context, value apiobjects.MonitoringHardware) (MonitoringHardwareConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	cpuObj := MonitoringCPUData{
+		Usage: types.Float64Value(value.CPU.Usage),
+		Cores: types.Int64Value(value.CPU.Cores),
+	}
+
+	cpu, err := types.ObjectValueFrom(ctx, MonitoringCPUType, cpuObj)
+	diags.Append(err...)
+
+	memory, err := monitoringCapacityValueFrom(ctx, value.Memory)
+	diags.Append(err...)
+
+	disk, err := monitoringCapacityValueFrom(ctx, value.Disk)
+	diags.Append(err...)
+
+	javaHeap, err := monitoringCapacityValueFrom(ctx, value.JavaHeap)
+	diags.Append(err...)
+
+	if diags.HasError() {
+		return MonitoringHardwareConfig{}, diags
+	}
+
+	model := &MonitoringHardwareConfig{
+		CPU:      cpu,
+		Memory:   memory,
+		Disk:     disk,
+		JavaHeap: javaHeap,
+	}
+
+	return *model, diags
+}
+
+func MonitoringPerformanceValueFrom(ctx context.Context, plan MonitoringPerformanceConfig, value apiobjects.MonitoringPerformance) (MonitoringPerformanceConfig, error) {
+	backends := []MonitoringPerformanceBackend{}
+	for _, backend := range value.Backends {
+		c := MonitoringPerformanceBackend{
+			VirtualHost:     types.StringValue(backend.VirtualHost),
+			VirtualPort:     types.StringValue(backend.VirtualPort),
+			Protocol:        types.StringValue(backend.Protocol),
+			Requests:        types.Int64Value(backend.Requests),
+			AverageDuration: types.Int64Value(backend.AverageDuration),
+			MaximumDuration: types.Int64Value(backend.MaximumDuration),
+		}
+		backends = append(backends, c)
+	}
+
+	model := &MonitoringPerformanceConfig{
+		RegionHost:     plan.RegionHost,
+		Subaccount:     plan.Subaccount,
+		SinceTimeStamp: types.Int64Value(value.SinceTimeStamp),
+		Backends:       backends,
+	}
+
+	return *model, nil
+}
+
+func MonitoringTopTimeConsumersValueFrom(ctx context.Context, plan MonitoringTopTimeConsumersConfig, value apiobjects.MonitoringTopTimeConsumers) (MonitoringTopTimeConsumersConfig, error) {
+	consumers := []MonitoringTopTimeConsumer{}
+	for _, consumer := range value.TopTimeConsumers {
+		c := MonitoringTopTimeConsumer{
+			TimeStamp:       types.Int64Value(consumer.TimeStamp),
+			VirtualHost:     types.StringValue(consumer.VirtualHost),
+			VirtualPort:     types.StringValue(consumer.VirtualPort),
+			Protocol:        types.StringValue(consumer.Protocol),
+			Resource:        types.StringValue(consumer.Resource),
+			User:            types.StringValue(consumer.User),
+			TotalDuration:   types.Int64Value(consumer.TotalDuration),
+			BackendDuration: types.Int64Value(consumer.BackendDuration),
+			SentBytes:       types.Int64Value(consumer.SentBytes),
+			ReceivedBytes:   types.Int64Value(consumer.ReceivedBytes),
+		}
+		consumers = append(consumers, c)
+	}
+
+	model := &MonitoringTopTimeConsumersConfig{
+		RegionHost:       plan.RegionHost,
+		Subaccount:       plan.Subaccount,
+		TopTimeConsumers: consumers,
+	}
+
+	return *model, nil
+}
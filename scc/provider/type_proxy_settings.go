@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ProxySettingsConfig struct {
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int64  `tfsdk:"port"`
+	User              types.String `tfsdk:"user"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	NonProxyHosts     types.List   `tfsdk:"non_proxy_hosts"`
+}
+
+func ProxySettingsValueFrom(ctx context.Context, plan ProxySettingsConfig, value apiobjects.ProxySettings) (ProxySettingsConfig, diag.Diagnostics) {
+	if value.NonProxyHosts == nil {
+		value.NonProxyHosts = []string{}
+	}
+
+	nonProxyHosts, diags := types.ListValueFrom(ctx, types.StringType, value.NonProxyHosts)
+	if diags.HasError() {
+		return ProxySettingsConfig{}, diags
+	}
+
+	model := &ProxySettingsConfig{
+		Host:              types.StringValue(value.Host),
+		Port:              types.Int64Value(value.Port),
+		User:              types.StringValue(value.User),
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: plan.PasswordWOVersion,
+		NonProxyHosts:     nonProxyHosts,
+	}
+
+	return *model, diags
+}
@@ -0,0 +1,13 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ServiceChannelToggleConfig struct {
+	RegionHost types.String `tfsdk:"region_host"`
+	Subaccount types.String `tfsdk:"subaccount"`
+	Type       types.String `tfsdk:"type"`
+	ID         types.Int64  `tfsdk:"id"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+}
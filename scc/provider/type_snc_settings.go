@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SNCSettingsConfig struct {
+	LibraryPath         types.String `tfsdk:"library_path"`
+	MyName              types.String `tfsdk:"my_name"`
+	QualityOfProtection types.String `tfsdk:"quality_of_protection"`
+}
+
+func SNCSettingsValueFrom(ctx context.Context, value apiobjects.SNCSettings) (SNCSettingsConfig, diag.Diagnostics) {
+	model := &SNCSettingsConfig{
+		LibraryPath:         types.StringValue(value.LibraryPath),
+		MyName:              types.StringValue(value.MyName),
+		QualityOfProtection: types.StringValue(value.QualityOfProtection),
+	}
+
+	return *model, diag.Diagnostics{}
+}
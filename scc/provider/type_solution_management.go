@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SolutionManagementConfig struct {
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	HostAgentPath        types.String `tfsdk:"host_agent_path"`
+	TargetSystemType     types.String `tfsdk:"target_system_type"`
+	TargetURL            types.String `tfsdk:"target_url"`
+	RegistrationFilePath types.String `tfsdk:"registration_file_path"`
+}
+
+func SolutionManagementValueFrom(ctx context.Context, plan SolutionManagementConfig, value apiobjects.SolutionManagement) (SolutionManagementConfig, diag.Diagnostics) {
+	model := &SolutionManagementConfig{
+		Enabled:              types.BoolValue(value.Enabled),
+		HostAgentPath:        types.StringValue(value.HostAgentPath),
+		TargetSystemType:     types.StringValue(value.TargetSystemType),
+		TargetURL:            types.StringValue(value.TargetURL),
+		RegistrationFilePath: plan.RegistrationFilePath,
+	}
+
+	return *model, diag.Diagnostics{}
+}
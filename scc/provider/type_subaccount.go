@@ -101,24 +101,57 @@ type SubaccountsConfig struct {
 }
 
 type SubaccountConfig struct {
-	RegionHost    types.String `tfsdk:"region_host"`
-	Subaccount    types.String `tfsdk:"subaccount"`
-	CloudUser     types.String `tfsdk:"cloud_user"`
-	CloudPassword types.String `tfsdk:"cloud_password"`
-	LocationID    types.String `tfsdk:"location_id"`
-	DisplayName   types.String `tfsdk:"display_name"`
-	Description   types.String `tfsdk:"description"`
-	Tunnel        types.Object `tfsdk:"tunnel"`
+	RegionHost             types.String `tfsdk:"region_host"`
+	Subaccount             types.String `tfsdk:"subaccount"`
+	CloudUser              types.String `tfsdk:"cloud_user"`
+	CloudPassword          types.String `tfsdk:"cloud_password"`
+	CloudPasswordWO        types.String `tfsdk:"cloud_password_wo"`
+	CloudPasswordWOVersion types.Int64  `tfsdk:"cloud_password_wo_version"`
+	LocationID             types.String `tfsdk:"location_id"`
+	DisplayName            types.String `tfsdk:"display_name"`
+	Description            types.String `tfsdk:"description"`
+	SyncTrustOnApply       types.Bool   `tfsdk:"sync_trust_on_apply"`
+	Tunnel                 types.Object `tfsdk:"tunnel"`
 }
 
 type SubaccountUsingAuthConfig struct {
-	RegionHost         types.String `tfsdk:"region_host"`
-	Subaccount         types.String `tfsdk:"subaccount"`
-	AuthenticationData types.String `tfsdk:"authentication_data"`
-	LocationID         types.String `tfsdk:"location_id"`
-	DisplayName        types.String `tfsdk:"display_name"`
-	Description        types.String `tfsdk:"description"`
-	Tunnel             types.Object `tfsdk:"tunnel"`
+	RegionHost                  types.String `tfsdk:"region_host"`
+	Subaccount                  types.String `tfsdk:"subaccount"`
+	AuthenticationData          types.String `tfsdk:"authentication_data"`
+	AuthenticationDataWO        types.String `tfsdk:"authentication_data_wo"`
+	AuthenticationDataWOVersion types.Int64  `tfsdk:"authentication_data_wo_version"`
+	LocationID                  types.String `tfsdk:"location_id"`
+	DisplayName                 types.String `tfsdk:"display_name"`
+	Description                 types.String `tfsdk:"description"`
+	SyncTrustOnApply            types.Bool   `tfsdk:"sync_trust_on_apply"`
+	Tunnel                      types.Object `tfsdk:"tunnel"`
+}
+
+type SubaccountReconnectConfig struct {
+	RegionHost types.String `tfsdk:"region_host"`
+	Subaccount types.String `tfsdk:"subaccount"`
+	Operation  types.String `tfsdk:"operation"`
+}
+
+type SubaccountSyncTrustConfig struct {
+	RegionHost types.String `tfsdk:"region_host"`
+	Subaccount types.String `tfsdk:"subaccount"`
+}
+
+type SubaccountListConfig struct {
+	RegionHost types.String `tfsdk:"region_host"`
+	Subaccount types.String `tfsdk:"subaccount"`
+}
+
+type SubaccountAuthenticationDataConfig struct {
+	File                types.String `tfsdk:"file"`
+	EnvironmentVariable types.String `tfsdk:"environment_variable"`
+	URL                 types.String `tfsdk:"url"`
+	HTTPHeaders         types.Map    `tfsdk:"http_headers"`
+	AuthenticationData  types.String `tfsdk:"authentication_data"`
+	RegionHost          types.String `tfsdk:"region_host"`
+	Subaccount          types.String `tfsdk:"subaccount"`
+	ExpiryTimeStamp     types.Int64  `tfsdk:"expiry_time_stamp"`
 }
 
 func SubaccountsDataSourceValueFrom(value apiobjects.SubaccountsDataSource) (SubaccountsConfig, diag.Diagnostics) {
@@ -273,14 +306,17 @@ func SubaccountResourceValueFrom(ctx context.Context, plan SubaccountConfig, val
 	}
 
 	model := &SubaccountConfig{
-		RegionHost:    types.StringValue(value.RegionHost),
-		Subaccount:    types.StringValue(value.Subaccount),
-		LocationID:    types.StringValue(value.LocationID),
-		DisplayName:   types.StringValue(value.DisplayName),
-		Description:   types.StringValue(value.Description),
-		CloudUser:     plan.CloudUser,
-		CloudPassword: plan.CloudPassword,
-		Tunnel:        tunnel,
+		RegionHost:             types.StringValue(value.RegionHost),
+		Subaccount:             types.StringValue(value.Subaccount),
+		LocationID:             types.StringValue(value.LocationID),
+		DisplayName:            types.StringValue(value.DisplayName),
+		Description:            types.StringValue(value.Description),
+		CloudUser:              plan.CloudUser,
+		CloudPassword:          plan.CloudPassword,
+		CloudPasswordWO:        types.StringNull(),
+		CloudPasswordWOVersion: plan.CloudPasswordWOVersion,
+		SyncTrustOnApply:       plan.SyncTrustOnApply,
+		Tunnel:                 tunnel,
 	}
 	return *model, diag.Diagnostics{}
 }
@@ -348,13 +384,29 @@ func SubaccountUsingAuthResourceValueFrom(ctx context.Context, plan SubaccountUs
 	}
 
 	model := &SubaccountUsingAuthConfig{
-		RegionHost:         types.StringValue(value.RegionHost),
-		Subaccount:         types.StringValue(value.Subaccount),
-		AuthenticationData: plan.AuthenticationData,
-		LocationID:         types.StringValue(value.LocationID),
-		DisplayName:        types.StringValue(value.DisplayName),
-		Description:        types.StringValue(value.Description),
-		Tunnel:             tunnel,
+		RegionHost:                  types.StringValue(value.RegionHost),
+		Subaccount:                  types.StringValue(value.Subaccount),
+		AuthenticationData:          plan.AuthenticationData,
+		AuthenticationDataWO:        types.StringNull(),
+		AuthenticationDataWOVersion: plan.AuthenticationDataWOVersion,
+		LocationID:                  types.StringValue(value.LocationID),
+		DisplayName:                 types.StringValue(value.DisplayName),
+		Description:                 types.StringValue(value.Description),
+		SyncTrustOnApply:            plan.SyncTrustOnApply,
+		Tunnel:                      tunnel,
 	}
 	return *model, diag.Diagnostics{}
 }
+
+func SubaccountAuthenticationDataValueFrom(config SubaccountAuthenticationDataConfig, authenticationData string, value subaccountAuthenticationData) SubaccountAuthenticationDataConfig {
+	return SubaccountAuthenticationDataConfig{
+		File:                config.File,
+		EnvironmentVariable: config.EnvironmentVariable,
+		URL:                 config.URL,
+		HTTPHeaders:         config.HTTPHeaders,
+		AuthenticationData:  types.StringValue(authenticationData),
+		RegionHost:          types.StringValue(value.RegionHost),
+		Subaccount:          types.StringValue(value.Subaccount),
+		ExpiryTimeStamp:     types.Int64Value(value.ExpiresAt),
+	}
+}
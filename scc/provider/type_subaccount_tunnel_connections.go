@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SubaccountTunnelConnectionsConfig struct {
+	RegionHost  types.String                 `tfsdk:"region_host"`
+	Subaccount  types.String                 `tfsdk:"subaccount"`
+	Application types.String                 `tfsdk:"application"`
+	VirtualHost types.String                 `tfsdk:"virtual_host"`
+	Connections []SubaccountTunnelConnection `tfsdk:"connections"`
+}
+
+type SubaccountTunnelConnection struct {
+	Application          types.String `tfsdk:"application"`
+	VirtualHost          types.String `tfsdk:"virtual_host"`
+	VirtualPort          types.String `tfsdk:"virtual_port"`
+	Protocol             types.String `tfsdk:"protocol"`
+	OpenedSinceTimeStamp types.Int64  `tfsdk:"opened_since_time_stamp"`
+	SentBytes            types.Int64  `tfsdk:"sent_bytes"`
+	ReceivedBytes        types.Int64  `tfsdk:"received_bytes"`
+}
+
+func SubaccountTunnelConnectionsValueFrom(ctx context.Context, plan SubaccountTunnelConnectionsConfig, value apiobjects.SubaccountTunnelConnections) (SubaccountTunnelConnectionsConfig, error) {
+	connections := []SubaccountTunnelConnection{}
+	for _, connection := range value.Connections {
+		if !plan.Application.IsNull() && connection.Application != plan.Application.ValueString() {
+			continue
+		}
+		if !plan.VirtualHost.IsNull() && connection.VirtualHost != plan.VirtualHost.ValueString() {
+			continue
+		}
+
+		c := SubaccountTunnelConnection{
+			Application:          types.StringValue(connection.Application),
+			VirtualHost:          types.StringValue(connection.VirtualHost),
+			VirtualPort:          types.StringValue(connection.VirtualPort),
+			Protocol:             types.StringValue(connection.Protocol),
+			OpenedSinceTimeStamp: types.Int64Value(connection.OpenedSinceTimeStamp),
+			SentBytes:            types.Int64Value(connection.SentBytes),
+			ReceivedBytes:        types.Int64Value(connection.ReceivedBytes),
+		}
+		connections = append(connections, c)
+	}
+
+	model := &SubaccountTunnelConnectionsConfig{
+		RegionHost:  plan.RegionHost,
+		Subaccount:  plan.Subaccount,
+		Application: plan.Application,
+		VirtualHost: plan.VirtualHost,
+		Connections: connections,
+	}
+
+	return *model, nil
+}
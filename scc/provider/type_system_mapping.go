@@ -7,6 +7,46 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var systemMappingProtocols = []docValue{
+	{value: "HTTP", description: "HTTP protocol"},
+	{value: "HTTPS", description: "Secure HTTP protocol"},
+	{value: "RFC", description: "Remote Function Call protocol"},
+	{value: "RFCS", description: "Secure RFC protocol. Requires SNC to be configured on the Cloud Connector, see `scc_snc_settings`."},
+	{value: "LDAP", description: "Lightweight Directory Access Protocol"},
+	{value: "LDAPS", description: "Secure LDAP"},
+	{value: "TCP", description: "Transmission Control Protocol"},
+	{value: "TCPS", description: "Secure TCP"},
+}
+
+var systemMappingBackendTypes = []docValue{
+	{value: "abapSys", description: "ABAP-based SAP system"},
+	{value: "netweaverCE", description: "SAP NetWeaver Composition Environment"},
+	{value: "netweaverGW", description: "SAP NetWeaver Gateway"},
+	{value: "applServerJava", description: "Java-based application server"},
+	{value: "PI", description: "SAP Process Integration system"},
+	{value: "hana", description: "SAP HANA system"},
+	{value: "otherSAPsys", description: "Other SAP system"},
+	{value: "nonSAPsys", description: "Non-SAP system"},
+}
+
+var systemMappingAuthenticationModes = []docValue{
+	{value: "NONE", description: "No authentication"},
+	{value: "NONE_RESTRICTED", description: "No authentication; system certificate will never be sent"},
+	{value: "X509_GENERAL", description: "X.509 certificate-based authentication, system certificate may be sent"},
+	{value: "X509_RESTRICTED", description: "X.509 certificate-based authentication, system certificate never sent"},
+	{value: "KERBEROS", description: "Kerberos-based authentication. Only applicable to HTTP(S) protocols. Requires Kerberos to be configured on the Cloud Connector, see `scc_kerberos_settings`."},
+}
+
+var systemMappingHostInHeaderPolicies = []docValue{
+	{value: "internal/INTERNAL", description: "Use internal (local) host for HTTP headers"},
+	{value: "virtual/VIRTUAL", description: "Use virtual host (default) for HTTP headers"},
+}
+
+// isHTTPProtocol reports whether the protocol of a system mapping is HTTP or HTTPS.
+func isHTTPProtocol(protocol string) bool {
+	return protocol == "HTTP" || protocol == "HTTPS"
+}
+
 type SystemMappingConfig struct {
 	RegionHost            types.String `tfsdk:"region_host"`
 	Subaccount            types.String `tfsdk:"subaccount"`
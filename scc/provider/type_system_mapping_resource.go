@@ -38,6 +38,13 @@ type SystemMappingResourcesConfig struct {
 	SystemMappingResources []SystemMappingResourceData `tfsdk:"system_mapping_resources"`
 }
 
+type SystemMappingResourceListConfig struct {
+	RegionHost  types.String `tfsdk:"region_host"`
+	Subaccount  types.String `tfsdk:"subaccount"`
+	VirtualHost types.String `tfsdk:"virtual_host"`
+	VirtualPort types.String `tfsdk:"virtual_port"`
+}
+
 func SystemMappingResourceValueFrom(ctx context.Context, plan SystemMappingResourceConfig, value apiobjects.SystemMappingResource) (SystemMappingResourceConfig, error) {
 	model := &SystemMappingResourceConfig{
 		RegionHost:              plan.RegionHost,
@@ -97,3 +104,10 @@ func CreateEncodedResourceID(input string) (encodedResourceID string) {
 
 	return input
 }
+
+/*
+DecodeResourceID reverts the encoding of CreateEncodedResourceID and returns the original resource ID.
+*/
+func DecodeResourceID(input string) (resourceID string) {
+	return strings.NewReplacer("-", "/", "+2D", "-", "+2B", "+").Replace(input)
+}